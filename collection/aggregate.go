@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// Aggregate evaluates several named reducers over the underlying slice in a
+// single pass and returns their results keyed by the same names.
+//
+// Each selector must:
+//   - Be a function type
+//   - Take two arguments: (accumulator, element), where the element type matches the slice
+//   - Return exactly one value, which must match the accumulator type
+//
+// Every accumulator starts from the zero value of its type. Selectors are
+// validated before any element is visited, so an invalid selector produces an
+// error without calling the others.
+//
+// Example:
+//
+//	results, err := FromSlice([]int{1, 2, 3}).Aggregate(map[string]any{
+//	    "sum":   func(acc, n int) int { return acc + n },
+//	    "count": func(acc, _ int) int { return acc + 1 },
+//	})
+//	// results == map[string]any{"sum": 6, "count": 3}
+func (c Collection) Aggregate(selectors map[string]any) (map[string]any, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	elemType := v.Type().Elem()
+
+	// Visit the selectors in a stable order so validation errors are deterministic.
+	names := slices.Sorted(maps.Keys(selectors))
+	fns := make([]reflect.Value, len(names))
+	accs := make([]reflect.Value, len(names))
+
+	for i, name := range names {
+		fVal := reflect.ValueOf(selectors[name])
+
+		// Check to make sure the selector is a reducer over the slice element type.
+		if fVal.Kind() != reflect.Func ||
+			fVal.Type().NumIn() != 2 ||
+			!elemType.AssignableTo(fVal.Type().In(1)) ||
+			fVal.Type().NumOut() != 1 ||
+			!fVal.Type().Out(0).AssignableTo(fVal.Type().In(0)) {
+			return nil, fmt.Errorf("Aggregate() selector %q must be a function of the form func(acc A, elem %s) A", name, elemType)
+		}
+
+		fns[i] = fVal
+		accs[i] = reflect.Zero(fVal.Type().In(0))
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		for j, fVal := range fns {
+			accs[j] = fVal.Call([]reflect.Value{accs[j], elem})[0]
+		}
+	}
+
+	results := make(map[string]any, len(names))
+	for i, name := range names {
+		results[name] = accs[i].Interface()
+	}
+
+	return results, nil
+}
@@ -0,0 +1,149 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAggregate(t *testing.T) {
+	t.Run("successful aggregate", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			input     any
+			selectors map[string]any
+			expected  map[string]any
+		}{
+			{
+				name:  "sum and count together",
+				input: []int{1, 2, 3, 4},
+				selectors: map[string]any{
+					"sum":   func(acc, n int) int { return acc + n },
+					"count": func(acc, _ int) int { return acc + 1 },
+				},
+				expected: map[string]any{"sum": 10, "count": 4},
+			},
+			{
+				name:  "accumulators of different types",
+				input: []string{"go", "rust", "c"},
+				selectors: map[string]any{
+					"joined":  func(acc, s string) string { return acc + s },
+					"longest": func(acc int, s string) int { return max(acc, len(s)) },
+					"hasC":    func(acc bool, s string) bool { return acc || s == "c" },
+				},
+				expected: map[string]any{"joined": "gorustc", "longest": 4, "hasC": true},
+			},
+			{
+				name:  "empty slice yields zero values",
+				input: []int{},
+				selectors: map[string]any{
+					"sum": func(acc, n int) int { return acc + n },
+				},
+				expected: map[string]any{"sum": 0},
+			},
+			{
+				name:      "no selectors",
+				input:     []int{1, 2},
+				selectors: map[string]any{},
+				expected:  map[string]any{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Aggregate(tt.selectors)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("single pass over the elements", func(t *testing.T) {
+		visits := 0
+
+		_, err := FromSlice([]int{1, 2, 3}).
+			Map(func(n int) int {
+				visits++
+				return n
+			}).
+			Aggregate(map[string]any{
+				"sum":   func(acc, n int) int { return acc + n },
+				"count": func(acc, _ int) int { return acc + 1 },
+			})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if visits != 3 {
+			t.Errorf("expected 3 visits, got %d", visits)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			setup     Collection
+			selectors map[string]any
+			errorMsg  string
+		}{
+			{
+				name:      "collection with existing error",
+				setup:     Collection{data: nil, err: errors.New("existing error")},
+				selectors: map[string]any{"sum": func(acc, n int) int { return acc + n }},
+				errorMsg:  "existing error",
+			},
+			{
+				name:      "non-slice data",
+				setup:     Collection{data: 42},
+				selectors: map[string]any{"sum": func(acc, n int) int { return acc + n }},
+				errorMsg:  "underlying data is not a slice",
+			},
+			{
+				name:      "selector is not a function",
+				setup:     FromSlice([]int{1, 2}),
+				selectors: map[string]any{"sum": 5},
+				errorMsg:  `Aggregate() selector "sum" must be a function of the form func(acc A, elem int) A`,
+			},
+			{
+				name:      "wrong element type",
+				setup:     FromSlice([]int{1, 2}),
+				selectors: map[string]any{"len": func(acc int, s string) int { return acc + len(s) }},
+				errorMsg:  `Aggregate() selector "len" must be a function of the form func(acc A, elem int) A`,
+			},
+			{
+				name:      "return type differs from accumulator",
+				setup:     FromSlice([]int{1, 2}),
+				selectors: map[string]any{"bad": func(acc, n int) string { return "" }},
+				errorMsg:  `Aggregate() selector "bad" must be a function of the form func(acc A, elem int) A`,
+			},
+			{
+				name:  "one invalid selector among valid ones",
+				setup: FromSlice([]int{1, 2}),
+				selectors: map[string]any{
+					"sum":   func(acc, n int) int { return acc + n },
+					"wrong": func(n int) int { return n },
+				},
+				errorMsg: `Aggregate() selector "wrong"`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Aggregate(tt.selectors)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Chain concatenates the underlying slices of multiple Collections into a single
+// Collection, preserving the order of the inputs and of the elements within them.
+//
+// All Collections must hold slices of the same element type, although the slice
+// types themselves may differ (for example a named slice type and []T). The
+// result uses the slice type of the first Collection.
+//
+// If any input Collection carries an error, the first such error is returned in
+// the resulting Collection. Calling Chain without any Collections also results in an error,
+// since the element type cannot be determined.
+//
+// Example:
+//
+//	c := Chain(FromSlice([]int{1, 2}), FromSlice([]int{3}), FromSlice([]int{4, 5}))
+//	// c holds []int{1, 2, 3, 4, 5}
+func Chain(cs ...Collection) Collection {
+	if len(cs) == 0 {
+		return Collection{data: nil, err: errors.New("Chain() expects at least one Collection")}
+	}
+
+	for _, c := range cs {
+		if c.err != nil {
+			return Collection{data: nil, err: c.err}
+		}
+	}
+
+	first := reflect.ValueOf(cs[0].data)
+	if first.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	sliceType := first.Type()
+	elemType := sliceType.Elem()
+	total := 0
+
+	for _, c := range cs {
+		v := reflect.ValueOf(c.data)
+		if v.Kind() != reflect.Slice {
+			return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+		}
+
+		if v.Type().Elem() != elemType {
+			return Collection{data: nil, err: fmt.Errorf("Chain() expects all Collections to hold elements of type %s. Got %s", elemType, v.Type().Elem())}
+		}
+
+		total += v.Len()
+	}
+
+	resultSlice := reflect.MakeSlice(sliceType, 0, total)
+	for _, c := range cs {
+		resultSlice = reflect.AppendSlice(resultSlice, reflect.ValueOf(c.data))
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
@@ -0,0 +1,124 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type chainInts []int
+
+func TestChain(t *testing.T) {
+	t.Run("successful chain", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    []Collection
+			expected any
+		}{
+			{
+				name:     "three int collections",
+				input:    []Collection{FromSlice([]int{1, 2}), FromSlice([]int{3}), FromSlice([]int{4, 5})},
+				expected: []int{1, 2, 3, 4, 5},
+			},
+			{
+				name:     "single collection",
+				input:    []Collection{FromSlice([]string{"a", "b"})},
+				expected: []string{"a", "b"},
+			},
+			{
+				name:     "with empty collections",
+				input:    []Collection{FromSlice([]int{}), FromSlice([]int{1}), FromSlice([]int{})},
+				expected: []int{1},
+			},
+			{
+				name: "after other operations",
+				input: []Collection{
+					FromSlice([]int{1, 2, 3, 4}).Filter(func(n int) bool { return n%2 == 0 }),
+					FromSlice([]int{5}).Map(func(n int) int { return n * 10 }),
+				},
+				expected: []int{2, 4, 50},
+			},
+			{
+				name:     "named slice type with same element type",
+				input:    []Collection{FromSlice(chainInts{1, 2}), FromSlice([]int{3})},
+				expected: chainInts{1, 2, 3},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := Chain(tt.input...).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected data %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("inputs are not modified", func(t *testing.T) {
+		a := []int{1, 2}
+		b := []int{3}
+
+		result, err := ToTypedSlice[int](Chain(FromSlice(a[:1:1]), FromSlice(b)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		result[0] = 100
+		if a[0] != 1 || a[1] != 2 || b[0] != 3 {
+			t.Errorf("expected inputs to be unchanged, got %v and %v", a, b)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    []Collection
+			errorMsg string
+		}{
+			{
+				name:     "no collections",
+				input:    []Collection{},
+				errorMsg: "Chain() expects at least one Collection",
+			},
+			{
+				name:     "first collection has error",
+				input:    []Collection{{data: nil, err: errors.New("existing error")}, FromSlice([]int{1})},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "middle collection has error",
+				input:    []Collection{FromSlice([]int{1}), FromSlice([]int{1}).Map("not a function"), FromSlice([]int{2})},
+				errorMsg: "Map() function must take exactly one argument of type int",
+			},
+			{
+				name:     "last collection has error",
+				input:    []Collection{FromSlice([]int{1}), FromSlice(42)},
+				errorMsg: "FromSlice() expects a slice",
+			},
+			{
+				name:     "mismatched element types",
+				input:    []Collection{FromSlice([]int{1}), FromSlice([]string{"a"})},
+				errorMsg: "Chain() expects all Collections to hold elements of type int. Got string",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := Chain(tt.input...).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
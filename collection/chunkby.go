@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ChunkBy splits the underlying slice into consecutive chunks, starting a new
+// chunk between two adjacent elements whenever the provided function returns
+// true for them. The result is a slice of slices ([][]T) in the original order.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take two arguments, both matching the element type of the slice: the previous and the current element
+//   - Return exactly one bool value, reporting whether a new chunk should start at the current element
+//
+// An empty Collection results in an empty slice of chunks. Each chunk shares its
+// backing array with the underlying slice but has its capacity capped, so appending
+// to a chunk won't overwrite its neighbours.
+//
+// Example:
+//
+//	runs, err := FromSlice([]int{1, 1, 2, 3, 3}).ChunkBy(func(prev, cur int) bool {
+//	    return prev != cur
+//	})
+//	// runs == [][]int{{1, 1}, {2}, {3, 3}}
+func (c Collection) ChunkBy(f any) (any, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	if fType.Kind() != reflect.Func ||
+		fType.NumIn() != 2 ||
+		!fType.In(0).AssignableTo(elemType) ||
+		!fType.In(1).AssignableTo(elemType) {
+		return nil, fmt.Errorf("ChunkBy() function must take exactly two arguments of type %s", elemType)
+	}
+
+	if fType.NumOut() != 1 || fType.Out(0).Kind() != reflect.Bool {
+		return nil, errors.New("ChunkBy() function must return exactly one bool value")
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(v.Type()), 0, 0)
+	if v.Len() == 0 {
+		return result.Interface(), nil
+	}
+
+	start := 0
+	for i := 1; i < v.Len(); i++ {
+		out := fVal.Call([]reflect.Value{v.Index(i - 1), v.Index(i)})
+		if out[0].Bool() {
+			result = reflect.Append(result, v.Slice3(start, i, i))
+			start = i
+		}
+	}
+
+	result = reflect.Append(result, v.Slice3(start, v.Len(), v.Len()))
+
+	return result.Interface(), nil
+}
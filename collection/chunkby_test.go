@@ -0,0 +1,136 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChunkBy(t *testing.T) {
+	t.Run("successful chunking", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			f        any
+			expected any
+		}{
+			{
+				name:     "consecutive equal elements",
+				input:    []int{1, 1, 2, 3, 3, 3, 1},
+				f:        func(prev, cur int) bool { return prev != cur },
+				expected: [][]int{{1, 1}, {2}, {3, 3, 3}, {1}},
+			},
+			{
+				name:     "split where sorted values jump",
+				input:    []int{1, 2, 3, 10, 11, 20},
+				f:        func(prev, cur int) bool { return cur-prev > 1 },
+				expected: [][]int{{1, 2, 3}, {10, 11}, {20}},
+			},
+			{
+				name:     "always false yields a single chunk",
+				input:    []string{"a", "b", "c"},
+				f:        func(prev, cur string) bool { return false },
+				expected: [][]string{{"a", "b", "c"}},
+			},
+			{
+				name:     "always true yields single element chunks",
+				input:    []string{"a", "b", "c"},
+				f:        func(prev, cur string) bool { return true },
+				expected: [][]string{{"a"}, {"b"}, {"c"}},
+			},
+			{
+				name:     "single element",
+				input:    []int{42},
+				f:        func(prev, cur int) bool { return true },
+				expected: [][]int{{42}},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				f:        func(prev, cur int) bool { return true },
+				expected: [][]int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).ChunkBy(tt.f)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("chunks don't overwrite each other", func(t *testing.T) {
+		result, err := FromSlice([]int{1, 1, 2}).ChunkBy(func(prev, cur int) bool { return prev != cur })
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		chunks := result.([][]int)
+		_ = append(chunks[0], 99)
+
+		if chunks[1][0] != 2 {
+			t.Errorf("expected appending to a chunk to leave the next chunk untouched, got %v", chunks)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			f        any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				f:        func(prev, cur int) bool { return prev != cur },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        "not a function",
+				errorMsg: "ChunkBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function with one argument",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(n int) bool { return n > 0 },
+				errorMsg: "ChunkBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function with wrong argument type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(prev, cur string) bool { return prev != cur },
+				errorMsg: "ChunkBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function returns non-bool",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(prev, cur int) int { return cur - prev },
+				errorMsg: "ChunkBy() function must return exactly one bool value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.ChunkBy(tt.f)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
+	"sync"
 )
 
 // Collection represents a wrapper around a slice, allowing chained
@@ -36,6 +38,51 @@ func FromSlice(s any) Collection {
 	return Collection{data: s, err: nil}
 }
 
+// FromMap creates a new Collection from the entries of a given map.
+//
+// The input must be a map type; otherwise, the returned Collection will carry
+// an error. Each element of the resulting Collection is a struct with two
+// exported fields, Key and Value, matching the map's key and value types:
+//
+//	struct {
+//	    Key   K
+//	    Value V
+//	}
+//
+// Because Go's map iteration order is random, the order of the elements in
+// the resulting Collection is arbitrary.
+//
+// Example:
+//
+//	c := FromMap(map[string]int{"a": 1}).Map(func(e struct {
+//	    Key   string
+//	    Value int
+//	}) int { return e.Value })
+func FromMap(m any) Collection {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map {
+		return Collection{data: nil, err: errors.New("FromMap() expects a map")}
+	}
+
+	entryType := reflect.StructOf([]reflect.StructField{
+		{Name: "Key", Type: v.Type().Key()},
+		{Name: "Value", Type: v.Type().Elem()},
+	})
+
+	resultSlice := reflect.MakeSlice(reflect.SliceOf(entryType), 0, v.Len())
+
+	iter := v.MapRange()
+	for iter.Next() {
+		entry := reflect.New(entryType).Elem()
+		entry.Field(0).Set(iter.Key())
+		entry.Field(1).Set(iter.Value())
+
+		resultSlice = reflect.Append(resultSlice, entry)
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
+
 // Map applies the provided function to each element of the underlying slice,
 // returning a new Collection with the transformed elements.
 //
@@ -87,6 +134,87 @@ func (c Collection) Map(f any) Collection {
 	return Collection{data: resultSlice.Interface(), err: nil}
 }
 
+// ParallelMap applies the provided function to each element of the underlying slice
+// concurrently using a worker pool, returning a new Collection with the transformed
+// elements in their original order.
+//
+// The provided function must satisfy the same requirements as Map. The number of
+// concurrent workers can be controlled via the optional workers parameter. If omitted
+// or set to a non-positive number, runtime.GOMAXPROCS(0) is used.
+//
+// The slice is split into contiguous index ranges, one per worker, and each result
+// is written into a preallocated slice by index, so no reordering is needed once
+// the workers finish. The provided function must be safe to call from
+// multiple goroutines.
+//
+// Example:
+//
+//	c := FromSlice([]int{1, 2, 3}).ParallelMap(func(n int) int { return n * n }, 4)
+func (c Collection) ParallelMap(f any, workers ...int) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	// Check to make sure data is a slice.
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fVal.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("ParallelMap() function must take exactly one argument of type %s", elemType)}
+	}
+
+	// Check to make sure f returns one value.
+	if fType.NumOut() != 1 {
+		return Collection{data: c.data, err: errors.New("ParallelMap() function must return exactly one value")}
+	}
+
+	outputType := fType.Out(0)
+	resultSlice := reflect.MakeSlice(reflect.SliceOf(outputType), v.Len(), v.Len())
+
+	if v.Len() == 0 {
+		return Collection{data: resultSlice.Interface(), err: nil}
+	}
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		workerCount = workers[0]
+	}
+
+	if workerCount > v.Len() {
+		workerCount = v.Len()
+	}
+
+	// Give each worker a contiguous range of indices so no channel is needed
+	// to hand out individual jobs.
+	chunkSize := (v.Len() + workerCount - 1) / workerCount
+
+	var wg sync.WaitGroup
+
+	for start := 0; start < v.Len(); start += chunkSize {
+		end := min(start+chunkSize, v.Len())
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for index := start; index < end; index++ {
+				out := fVal.Call([]reflect.Value{v.Index(index)})
+				resultSlice.Index(index).Set(out[0])
+			}
+		}(start, end)
+	}
+
+	wg.Wait()
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
+
 // Filter applies the provided function to each element of the underlying slice,
 // returning a new Collection containing only the elements for which the function returns true.
 //
@@ -228,6 +356,40 @@ func (c Collection) Reduce(reducer any, initial any) (any, error) {
 	return acc.Interface(), nil
 }
 
+// Len returns the number of elements in the underlying slice after all chained
+// operations, along with any accumulated error.
+//
+// Example:
+//
+//	n, err := FromSlice([]int{1, 2, 3}).Filter(...).Len()
+func (c Collection) Len() (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return 0, errors.New("underlying data is not a slice")
+	}
+
+	return v.Len(), nil
+}
+
+// IsEmpty returns true if the underlying slice contains no elements after all
+// chained operations, along with any accumulated error.
+//
+// Example:
+//
+//	empty, err := FromSlice([]int{1, 2, 3}).Filter(...).IsEmpty()
+func (c Collection) IsEmpty() (bool, error) {
+	length, err := c.Len()
+	if err != nil {
+		return false, err
+	}
+
+	return length == 0, nil
+}
+
 // ToSlice returns the underlying slice after all chained operations,
 // along with any accumulated error.
 //
@@ -249,6 +411,25 @@ func (c Collection) ToSlice() (any, error) {
 	return c.data, nil
 }
 
+// ToSliceOr returns the underlying slice after all chained operations, or
+// defaultVal if the chain carries an error. No error is returned, which makes
+// it convenient for code paths where a sane default is acceptable.
+//
+// defaultVal is returned as is, so making sure it has the type the caller
+// will type-assert the result to is the caller's responsibility.
+//
+// Example:
+//
+//	result := FromSlice([]int{1, 2, 3}).Map(...).ToSliceOr([]int{})
+func (c Collection) ToSliceOr(defaultVal any) any {
+	result, err := c.ToSlice()
+	if err != nil {
+		return defaultVal
+	}
+
+	return result
+}
+
 // ToTypedSlice casts the result of the Collection to a typed slice.
 //
 // It is a standalone generic function (not a method) due to Go's generic limitations.
@@ -273,3 +454,23 @@ func ToTypedSlice[T any](c Collection) ([]T, error) {
 
 	return slice, nil
 }
+
+// ToTypedMap casts a map-shaped result produced by the Collection (for example
+// the value returned by a reflective grouping or keying operation) to a typed map.
+//
+// It is a standalone generic function (not a method) due to Go's generic limitations.
+// The type parameters K and V specify the key and value types.
+//
+// Example:
+//
+//	grouped, err := ToTypedMap[string, []int](result)
+//
+// This function will return an error if the provided value cannot be cast to map[K]V.
+func ToTypedMap[K comparable, V any](result any) (map[K]V, error) {
+	m, ok := result.(map[K]V)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast %T to type %T", result, map[K]V(nil))
+	}
+
+	return m, nil
+}
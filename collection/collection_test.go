@@ -73,6 +73,89 @@ func TestFromSlice(t *testing.T) {
 	}
 }
 
+func TestFromMap(t *testing.T) {
+	type entry = struct {
+		Key   string
+		Value int
+	}
+
+	t.Run("map of string to int", func(t *testing.T) {
+		input := map[string]int{"one": 1, "two": 2, "three": 3}
+
+		c := FromMap(input)
+		if c.err != nil {
+			t.Fatalf("unexpected error: %v", c.err)
+		}
+
+		entries, ok := c.data.([]entry)
+		if !ok {
+			t.Fatalf("expected []struct{Key string; Value int}, got %T", c.data)
+		}
+
+		if len(entries) != len(input) {
+			t.Errorf("expected %d entries, got %d", len(input), len(entries))
+		}
+
+		seen := make(map[string]int, len(entries))
+		for _, e := range entries {
+			seen[e.Key] = e.Value
+		}
+
+		if !reflect.DeepEqual(seen, input) {
+			t.Errorf("expected entries %v, got %v", input, seen)
+		}
+	})
+
+	t.Run("chaining over entries", func(t *testing.T) {
+		sum, err := FromMap(map[string]int{"a": 1, "b": 2, "c": 3}).
+			Filter(func(e entry) bool { return e.Key != "b" }).
+			Map(func(e entry) int { return e.Value }).
+			Reduce(func(acc, n int) int { return acc + n }, 0)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if sum != 4 {
+			t.Errorf("expected sum 4, got %v", sum)
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		c := FromMap(map[string]int{})
+		if c.err != nil {
+			t.Fatalf("unexpected error: %v", c.err)
+		}
+
+		if reflect.ValueOf(c.data).Len() != 0 {
+			t.Errorf("expected no entries, got %v", c.data)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input any
+		}{
+			{name: "slice", input: []int{1, 2, 3}},
+			{name: "int", input: 42},
+			{name: "nil", input: nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := FromMap(tt.input)
+
+				if c.err == nil {
+					t.Errorf("expected error but got none")
+				} else if c.err.Error() != "FromMap() expects a map" {
+					t.Errorf("expected error %q, got %q", "FromMap() expects a map", c.err.Error())
+				}
+			})
+		}
+	})
+}
+
 func TestMap(t *testing.T) {
 	t.Run("successful mapping", func(t *testing.T) {
 		tests := []struct {
@@ -188,6 +271,151 @@ func TestMap(t *testing.T) {
 	})
 }
 
+func TestParallelMap(t *testing.T) {
+	t.Run("successful mapping", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			mapFunc  any
+			workers  []int
+			expected any
+		}{
+			{
+				name:     "int to string",
+				input:    []int{1, 2, 3},
+				mapFunc:  func(n int) string { return strconv.Itoa(n) },
+				expected: []string{"1", "2", "3"},
+			},
+			{
+				name:     "int multiplication with workers",
+				input:    []int{1, 2, 3, 4},
+				mapFunc:  func(n int) int { return n * 2 },
+				workers:  []int{3},
+				expected: []int{2, 4, 6, 8},
+			},
+			{
+				name:     "negative worker count",
+				input:    []int{1, 2, 3, 4},
+				mapFunc:  func(n int) int { return n * 2 },
+				workers:  []int{-5},
+				expected: []int{2, 4, 6, 8},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				mapFunc:  func(n int) string { return strconv.Itoa(n) },
+				expected: []string{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).ParallelMap(tt.mapFunc, tt.workers...).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected data %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("preserves order on large slice", func(t *testing.T) {
+		input := make([]int, 100000)
+		for i := range input {
+			input[i] = i
+		}
+
+		expected, _ := FromSlice(input).Map(func(n int) int { return n * 3 }).ToSlice()
+		result, err := FromSlice(input).ParallelMap(func(n int) int { return n * 3 }, 8).ToSlice()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Error("expected ParallelMap result to match Map result")
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			mapFunc  any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				mapFunc:  func(n int) string { return strconv.Itoa(n) },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				mapFunc:  "not a function",
+				errorMsg: "ParallelMap() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with wrong input type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				mapFunc:  func(s string) string { return s },
+				errorMsg: "ParallelMap() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with multiple returns",
+				setup:    FromSlice([]int{1, 2, 3}),
+				mapFunc:  func(n int) (string, error) { return strconv.Itoa(n), nil },
+				errorMsg: "ParallelMap() function must return exactly one value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := tt.setup.ParallelMap(tt.mapFunc)
+
+				if c.err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(c.err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, c.err.Error())
+				}
+			})
+		}
+	})
+}
+
+func BenchmarkMap(b *testing.B) {
+	input := make([]int, 1000000)
+	for i := range input {
+		input[i] = i
+	}
+
+	c := FromSlice(input)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Map(func(n int) int { return n * 2 })
+	}
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	input := make([]int, 1000000)
+	for i := range input {
+		input[i] = i
+	}
+
+	c := FromSlice(input)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.ParallelMap(func(n int) int { return n * 2 })
+	}
+}
+
 func TestFilter(t *testing.T) {
 	t.Run("successful filtering", func(t *testing.T) {
 		tests := []struct {
@@ -556,6 +784,107 @@ func TestReduce(t *testing.T) {
 	})
 }
 
+func TestLen(t *testing.T) {
+	t.Run("successful len", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			expected int
+		}{
+			{
+				name:     "int slice",
+				setup:    FromSlice([]int{1, 2, 3}),
+				expected: 3,
+			},
+			{
+				name:     "empty slice",
+				setup:    FromSlice([]int{}),
+				expected: 0,
+			},
+			{
+				name:     "after filter",
+				setup:    FromSlice([]int{1, 2, 3, 4}).Filter(func(n int) bool { return n%2 == 0 }),
+				expected: 2,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.setup.Len()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %d, got %d", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("collection with existing error", func(t *testing.T) {
+		c := Collection{data: nil, err: errors.New("existing error")}
+		_, err := c.Len()
+
+		if err == nil {
+			t.Errorf("expected error but got none")
+		} else if err.Error() != "existing error" {
+			t.Errorf("expected error %q, got %q", "existing error", err.Error())
+		}
+	})
+}
+
+func TestIsEmpty(t *testing.T) {
+	t.Run("successful isEmpty", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			expected bool
+		}{
+			{
+				name:     "empty slice",
+				setup:    FromSlice([]int{}),
+				expected: true,
+			},
+			{
+				name:     "filter that empties the collection",
+				setup:    FromSlice([]int{1, 3, 5}).Filter(func(n int) bool { return n%2 == 0 }),
+				expected: true,
+			},
+			{
+				name:     "filter that leaves elements",
+				setup:    FromSlice([]int{1, 2, 3}).Filter(func(n int) bool { return n%2 == 0 }),
+				expected: false,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.setup.IsEmpty()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error in chain", func(t *testing.T) {
+		_, err := FromSlice([]int{1, 2, 3}).Filter("not a function").IsEmpty()
+
+		if err == nil {
+			t.Errorf("expected error but got none")
+		} else if !strings.Contains(err.Error(), "Filter() function must take exactly one argument of type int") {
+			t.Errorf("expected filter error, got %q", err.Error())
+		}
+	})
+}
+
 func TestToSlice(t *testing.T) {
 	t.Run("successful toSlice", func(t *testing.T) {
 		tests := []struct {
@@ -610,6 +939,56 @@ func TestToSlice(t *testing.T) {
 	})
 }
 
+func TestToSliceOr(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    Collection
+		fallback any
+		expected any
+	}{
+		{
+			name:     "successful chain returns the slice",
+			setup:    FromSlice([]int{1, 2, 3}).Filter(func(n int) bool { return n > 1 }),
+			fallback: []int{},
+			expected: []int{2, 3},
+		},
+		{
+			name:     "empty slice is not replaced by the default",
+			setup:    FromSlice([]int{}),
+			fallback: []int{42},
+			expected: []int{},
+		},
+		{
+			name:     "broken chain returns the default",
+			setup:    FromSlice([]int{1, 2, 3}).Map("not a function"),
+			fallback: []int{42},
+			expected: []int{42},
+		},
+		{
+			name:     "non-slice data returns the default",
+			setup:    FromSlice(42),
+			fallback: []string{"default"},
+			expected: []string{"default"},
+		},
+		{
+			name:     "nil default",
+			setup:    Collection{data: nil, err: errors.New("existing error")},
+			fallback: nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.setup.ToSliceOr(tt.fallback)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestToTypedSlice(t *testing.T) {
 	t.Run("successful typed slice conversion", func(t *testing.T) {
 		t.Run("int slice", func(t *testing.T) {
@@ -782,3 +1161,71 @@ func TestChaining(t *testing.T) {
 		}
 	})
 }
+
+func TestToTypedMap(t *testing.T) {
+	t.Run("successful typed map conversion", func(t *testing.T) {
+		t.Run("string to int map", func(t *testing.T) {
+			var input any = map[string]int{"a": 1, "b": 2}
+
+			result, err := ToTypedMap[string, int](input)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 2}) {
+				t.Errorf("expected %v, got %v", input, result)
+			}
+		})
+
+		t.Run("int to slice map", func(t *testing.T) {
+			var input any = map[int][]string{1: {"a"}, 2: {"b", "c"}}
+
+			result, err := ToTypedMap[int, []string](input)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !reflect.DeepEqual(result, map[int][]string{1: {"a"}, 2: {"b", "c"}}) {
+				t.Errorf("expected %v, got %v", input, result)
+			}
+		})
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			errorMsg string
+		}{
+			{
+				name:     "wrong value type",
+				input:    map[string]int{"a": 1},
+				errorMsg: "cannot cast map[string]int to type map[string]string",
+			},
+			{
+				name:     "not a map",
+				input:    []string{"a"},
+				errorMsg: "cannot cast []string to type map[string]string",
+			},
+			{
+				name:     "nil",
+				input:    nil,
+				errorMsg: "cannot cast <nil> to type map[string]string",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := ToTypedMap[string, string](tt.input)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
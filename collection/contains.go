@@ -0,0 +1,104 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Contains reports whether any element of the underlying slice is equal to value.
+//
+// The element type of the slice must be comparable and value must be
+// assignable to it. A nil value is accepted for element types that can be nil,
+// such as pointers and interfaces. Use ContainsFunc for non-comparable element
+// types or custom matching.
+//
+// For interface element types such as any, value must also hold a comparable
+// dynamic value. Elements holding non-comparable values, such as slices or
+// maps, never match.
+//
+// Example:
+//
+//	found, err := FromSlice([]string{"a", "b"}).Contains("b")
+//	// found == true
+func (c Collection) Contains(value any) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return false, errors.New("underlying data is not a slice")
+	}
+
+	elemType := v.Type().Elem()
+
+	// Check to make sure the elements can be compared with ==.
+	if !elemType.Comparable() {
+		return false, fmt.Errorf("Contains() requires a comparable element type. Got %s", elemType)
+	}
+
+	// Check to make sure the value matches the slice element type. Converting to the
+	// element type makes interface element types compare by dynamic value.
+	target, ok := elemValue(value, elemType)
+	if !ok {
+		return false, fmt.Errorf("Contains() value must be of type %s", elemType)
+	}
+
+	// Check to make sure the dynamic value can be compared, which matters for interface element types.
+	if !target.Comparable() {
+		return false, fmt.Errorf("Contains() value must be comparable. Got %T", value)
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if v.Index(i).Equal(target) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ContainsFunc reports whether the provided predicate returns true for any
+// element of the underlying slice. It stops at the first match.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one bool value
+//
+// Example:
+//
+//	found, err := FromSlice(users).ContainsFunc(func(u User) bool { return u.Admin })
+func (c Collection) ContainsFunc(pred any) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return false, errors.New("underlying data is not a slice")
+	}
+
+	fVal := reflect.ValueOf(pred)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure pred is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return false, fmt.Errorf("ContainsFunc() function must take exactly one argument of type %s", elemType)
+	}
+
+	// Check to make sure pred returns a bool.
+	if fType.NumOut() != 1 || fType.Out(0).Kind() != reflect.Bool {
+		return false, errors.New("ContainsFunc() function must return exactly one bool value")
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if fVal.Call([]reflect.Value{v.Index(i)})[0].Bool() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,235 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	t.Run("successful contains", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			value    any
+			expected bool
+		}{
+			{
+				name:     "present int",
+				input:    []int{1, 2, 3},
+				value:    2,
+				expected: true,
+			},
+			{
+				name:     "absent int",
+				input:    []int{1, 2, 3},
+				value:    4,
+				expected: false,
+			},
+			{
+				name:     "present string",
+				input:    []string{"a", "b"},
+				value:    "b",
+				expected: true,
+			},
+			{
+				name:     "interface element type",
+				input:    []any{1, "2", 3.0},
+				value:    "2",
+				expected: true,
+			},
+			{
+				name:     "interface elements holding non-comparable values",
+				input:    []any{[]int{2}, map[string]int{"a": 2}, 2},
+				value:    2,
+				expected: true,
+			},
+			{
+				name:     "nil in interface elements",
+				input:    []any{1, nil},
+				value:    nil,
+				expected: true,
+			},
+			{
+				name:     "nil absent from pointer elements",
+				input:    []*int{new(int)},
+				value:    nil,
+				expected: false,
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				value:    1,
+				expected: false,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Contains(tt.value)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			value    any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				value:    1,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-comparable element type",
+				setup:    FromSlice([][]int{{1}}),
+				value:    []int{1},
+				errorMsg: "Contains() requires a comparable element type. Got []int",
+			},
+			{
+				name:     "value of wrong type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				value:    "1",
+				errorMsg: "Contains() value must be of type int",
+			},
+			{
+				name:     "nil value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				value:    nil,
+				errorMsg: "Contains() value must be of type int",
+			},
+			{
+				name:     "non-comparable value for interface element type",
+				setup:    FromSlice([]any{1, []int{2}}),
+				value:    []int{2},
+				errorMsg: "Contains() value must be comparable. Got []int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Contains(tt.value)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
+
+func TestContainsFunc(t *testing.T) {
+	t.Run("successful contains", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			pred     any
+			expected bool
+		}{
+			{
+				name:     "match",
+				input:    []int{1, 2, 3},
+				pred:     func(n int) bool { return n > 2 },
+				expected: true,
+			},
+			{
+				name:     "no match",
+				input:    []int{1, 2, 3},
+				pred:     func(n int) bool { return n > 3 },
+				expected: false,
+			},
+			{
+				name:     "non-comparable element type",
+				input:    [][]int{{1}, {2, 3}},
+				pred:     func(s []int) bool { return len(s) == 2 },
+				expected: true,
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				pred:     func(n int) bool { return true },
+				expected: false,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).ContainsFunc(tt.pred)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("stops at first match", func(t *testing.T) {
+		calls := 0
+		_, _ = FromSlice([]int{1, 2, 3, 4}).ContainsFunc(func(n int) bool {
+			calls++
+			return n == 2
+		})
+
+		if calls != 2 {
+			t.Errorf("expected predicate to be called 2 times, got %d", calls)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			pred     any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				pred:     func(n int) bool { return true },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "function with wrong argument type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				pred:     func(s string) bool { return true },
+				errorMsg: "ContainsFunc() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function returns non-bool",
+				setup:    FromSlice([]int{1, 2, 3}),
+				pred:     func(n int) int { return n },
+				errorMsg: "ContainsFunc() function must return exactly one bool value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.ContainsFunc(tt.pred)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// DebugWriter is where Debug writes its output. It defaults to os.Stderr and
+// can be replaced to redirect or capture debug output.
+var DebugWriter io.Writer = os.Stderr
+
+// debugPreviewLimit is the maximum number of elements Debug prints before
+// truncating the preview.
+const debugPreviewLimit = 10
+
+// Debug writes the given label, the length of the underlying slice, and a
+// preview of its first elements to DebugWriter, then returns the Collection
+// unchanged. It is meant to be dropped into a chain while debugging.
+//
+// If the Collection carries an error, the error is written instead of the data.
+//
+// Example:
+//
+//	result, err := FromSlice(nums).
+//	    Filter(isEven).
+//	    Debug("after filter").
+//	    Map(double).
+//	    ToSlice()
+func (c Collection) Debug(label string) Collection {
+	if c.err != nil {
+		fmt.Fprintf(DebugWriter, "[%s] error: %v\n", label, c.err)
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		fmt.Fprintf(DebugWriter, "[%s] error: underlying data is not a slice\n", label)
+		return c
+	}
+
+	n := min(v.Len(), debugPreviewLimit)
+	items := make([]string, n, n+1)
+	for i := 0; i < n; i++ {
+		items[i] = fmt.Sprintf("%v", v.Index(i).Interface())
+	}
+
+	if v.Len() > debugPreviewLimit {
+		items = append(items, fmt.Sprintf("... (%d more)", v.Len()-debugPreviewLimit))
+	}
+
+	fmt.Fprintf(DebugWriter, "[%s] len=%d data=[%s]\n", label, v.Len(), strings.Join(items, " "))
+
+	return c
+}
+
+// Dump returns a human-readable, multi-line rendering of the underlying slice
+// without writing it anywhere. The first line holds the element type and the
+// length of the slice, followed by one indented line per element:
+//
+//	Collection[int] len=2
+//	  [0] 10
+//	  [1] 20
+//
+// Unlike Debug, every element is rendered. If the Collection carries an error,
+// the returned string holds that error's message and the error is returned as well.
+//
+// Example:
+//
+//	dump, err := FromSlice(users).Filter(isActive).Dump()
+func (c Collection) Dump() (string, error) {
+	if c.err != nil {
+		return fmt.Sprintf("error: %v", c.err), c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		err := errors.New("underlying data is not a slice")
+		return fmt.Sprintf("error: %v", err), err
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Collection[%s] len=%d", v.Type().Elem(), v.Len())
+	for i := 0; i < v.Len(); i++ {
+		fmt.Fprintf(&sb, "\n  [%d] %v", i, v.Index(i).Interface())
+	}
+
+	return sb.String(), nil
+}
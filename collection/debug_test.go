@@ -0,0 +1,200 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDebug(t *testing.T) {
+	original := DebugWriter
+	defer func() { DebugWriter = original }()
+
+	t.Run("successful debug output", func(t *testing.T) {
+		scenarios := []struct {
+			name     string
+			input    any
+			label    string
+			contains []string
+			excludes []string
+		}{
+			{
+				name:     "Small int slice",
+				input:    []int{1, 2, 3},
+				label:    "ints",
+				contains: []string{"[ints]", "len=3", "data=[1 2 3]"},
+			},
+			{
+				name:     "Empty slice",
+				input:    []string{},
+				label:    "empty",
+				contains: []string{"[empty]", "len=0", "data=[]"},
+			},
+			{
+				name:     "Truncated preview",
+				input:    []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+				label:    "long",
+				contains: []string{"[long]", "len=12", "9 ... (2 more)"},
+				excludes: []string{"10", "11"},
+			},
+		}
+
+		for _, scenario := range scenarios {
+			t.Run(scenario.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				DebugWriter = &buf
+
+				c := FromSlice(scenario.input)
+				result := c.Debug(scenario.label)
+
+				if !reflect.DeepEqual(result, c) {
+					t.Errorf("Expected Debug to return the Collection unchanged")
+				}
+
+				output := buf.String()
+				for _, s := range scenario.contains {
+					if !strings.Contains(output, s) {
+						t.Errorf("Expected output %q to contain %q", output, s)
+					}
+				}
+
+				for _, s := range scenario.excludes {
+					if strings.Contains(output, s) {
+						t.Errorf("Expected output %q to not contain %q", output, s)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("chained usage", func(t *testing.T) {
+		var buf bytes.Buffer
+		DebugWriter = &buf
+
+		result, err := FromSlice([]int{1, 2, 3, 4}).
+			Filter(func(n int) bool { return n%2 == 0 }).
+			Debug("after filter").
+			Map(func(n int) int { return n * 10 }).
+			ToSlice()
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(result, []int{20, 40}) {
+			t.Errorf("Expected %v, got %v", []int{20, 40}, result)
+		}
+
+		if !strings.Contains(buf.String(), "[after filter] len=2 data=[2 4]") {
+			t.Errorf("Unexpected output %q", buf.String())
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		var buf bytes.Buffer
+		DebugWriter = &buf
+
+		c := Collection{data: []int{1}, err: errors.New("previous error")}
+		result := c.Debug("broken")
+
+		if result.err == nil || result.err.Error() != "previous error" {
+			t.Errorf("Expected error to be preserved. Got %v", result.err)
+		}
+
+		if !strings.Contains(buf.String(), "[broken] error: previous error") {
+			t.Errorf("Unexpected output %q", buf.String())
+		}
+	})
+}
+
+func TestDump(t *testing.T) {
+	t.Run("successful dump", func(t *testing.T) {
+		type point struct {
+			X, Y int
+		}
+
+		tests := []struct {
+			name     string
+			input    any
+			expected string
+		}{
+			{
+				name:     "int slice",
+				input:    []int{10, 20},
+				expected: "Collection[int] len=2\n  [0] 10\n  [1] 20",
+			},
+			{
+				name:     "struct slice",
+				input:    []point{{1, 2}},
+				expected: "Collection[collection.point] len=1\n  [0] {1 2}",
+			},
+			{
+				name:     "empty slice",
+				input:    []string{},
+				expected: "Collection[string] len=0",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Dump()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %q, got %q", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("dump does not truncate", func(t *testing.T) {
+		result, err := FromSlice(make([]int, debugPreviewLimit+5)).Dump()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if lines := strings.Count(result, "\n"); lines != debugPreviewLimit+5 {
+			t.Errorf("expected %d element lines, got %d", debugPreviewLimit+5, lines)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-slice data",
+				setup:    Collection{data: 42},
+				errorMsg: "underlying data is not a slice",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.setup.Dump()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+
+				if !strings.Contains(result, tt.errorMsg) {
+					t.Errorf("expected dump containing %q, got %q", tt.errorMsg, result)
+				}
+			})
+		}
+	})
+}
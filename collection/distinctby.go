@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// DistinctBy removes duplicate elements from the underlying slice, where two
+// elements are considered duplicates if the key function returns the same key
+// for both. The first element for each key is kept and the original order is
+// preserved.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one comparable value (the key)
+//
+// If the key type is an interface such as any, every key must also hold a
+// comparable dynamic value. A key holding a slice, map or function results in
+// an error.
+//
+// Example:
+//
+//	c := FromSlice(users).DistinctBy(func(u User) string { return u.Email })
+func (c Collection) DistinctBy(keyFn any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	fVal := reflect.ValueOf(keyFn)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("DistinctBy() function must take exactly one argument of type %s", elemType)}
+	}
+
+	// Check to make sure f returns one comparable key.
+	if fType.NumOut() != 1 || !fType.Out(0).Comparable() {
+		return Collection{data: c.data, err: errors.New("DistinctBy() function must return exactly one comparable value")}
+	}
+
+	seen := make(map[any]struct{}, v.Len())
+	resultSlice := reflect.MakeSlice(v.Type(), 0, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		keyVal := fVal.Call([]reflect.Value{v.Index(i)})[0]
+
+		// Check to make sure the dynamic key can be hashed, which matters for interface key types.
+		if !keyVal.Comparable() {
+			return Collection{data: c.data, err: fmt.Errorf("DistinctBy() key at index %d is not comparable. Got %s", i, dynamicType(keyVal))}
+		}
+
+		key := keyVal.Interface()
+		if _, found := seen[key]; found {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		resultSlice = reflect.Append(resultSlice, v.Index(i))
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
+
+// dynamicType returns the type of the value held by v, looking through
+// interface values to their concrete type.
+func dynamicType(v reflect.Value) reflect.Type {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		return v.Elem().Type()
+	}
+
+	return v.Type()
+}
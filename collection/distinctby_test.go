@@ -0,0 +1,137 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDistinctBy(t *testing.T) {
+	type user struct {
+		ID    int
+		Email string
+	}
+
+	t.Run("successful distinct", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			keyFunc  any
+			expected any
+		}{
+			{
+				name: "structs by field",
+				input: []user{
+					{ID: 1, Email: "a@example.com"},
+					{ID: 2, Email: "b@example.com"},
+					{ID: 3, Email: "a@example.com"},
+					{ID: 4, Email: "c@example.com"},
+					{ID: 5, Email: "b@example.com"},
+				},
+				keyFunc: func(u user) string { return u.Email },
+				expected: []user{
+					{ID: 1, Email: "a@example.com"},
+					{ID: 2, Email: "b@example.com"},
+					{ID: 4, Email: "c@example.com"},
+				},
+			},
+			{
+				name:     "ints by parity",
+				input:    []int{1, 3, 2, 5, 4},
+				keyFunc:  func(n int) bool { return n%2 == 0 },
+				expected: []int{1, 2},
+			},
+			{
+				name:     "all distinct",
+				input:    []string{"a", "bb", "ccc"},
+				keyFunc:  func(s string) int { return len(s) },
+				expected: []string{"a", "bb", "ccc"},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				keyFunc:  func(n int) int { return n },
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).DistinctBy(tt.keyFunc).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected data %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			keyFunc  any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				keyFunc:  func(n int) int { return n },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				keyFunc:  "not a function",
+				errorMsg: "DistinctBy() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with wrong input type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				keyFunc:  func(s string) string { return s },
+				errorMsg: "DistinctBy() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with no return",
+				setup:    FromSlice([]int{1, 2, 3}),
+				keyFunc:  func(n int) {},
+				errorMsg: "DistinctBy() function must return exactly one comparable value",
+			},
+			{
+				name:     "function returns non-comparable key",
+				setup:    FromSlice([]int{1, 2, 3}),
+				keyFunc:  func(n int) []int { return []int{n} },
+				errorMsg: "DistinctBy() function must return exactly one comparable value",
+			},
+			{
+				name:     "interface key holding a non-comparable value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				keyFunc:  func(n int) any { return []int{n} },
+				errorMsg: "DistinctBy() key at index 0 is not comparable. Got []int",
+			},
+			{
+				name:     "struct key holding a non-comparable value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				keyFunc:  func(n int) struct{ V any } { return struct{ V any }{V: map[int]int{}} },
+				errorMsg: "DistinctBy() key at index 0 is not comparable",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := tt.setup.DistinctBy(tt.keyFunc)
+
+				if c.err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(c.err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, c.err.Error())
+				}
+			})
+		}
+	})
+}
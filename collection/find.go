@@ -0,0 +1,131 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Find returns the first element of the underlying slice for which the
+// provided predicate returns true. The bool result reports whether a match was
+// found. It stops at the first match.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one bool value
+//
+// Example:
+//
+//	user, found, err := FromSlice(users).Find(func(u User) bool { return u.ID == 42 })
+func (c Collection) Find(pred any) (any, bool, error) {
+	match, _, err := c.find("Find", pred)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !match.IsValid() {
+		return nil, false, nil
+	}
+
+	return match.Interface(), true, nil
+}
+
+// FindOr returns the first element of the underlying slice for which the
+// provided predicate returns true, or defaultVal when no element matches.
+// Not finding a match is not an error.
+//
+// The predicate follows the same rules as Find. defaultVal must be assignable
+// to the element type of the slice. A nil defaultVal is accepted for element
+// types that can be nil, such as pointers and interfaces.
+//
+// Example:
+//
+//	name, err := FromSlice([]string{"ann", "bob"}).FindOr(func(s string) bool {
+//	    return strings.HasPrefix(s, "c")
+//	}, "nobody")
+//	// name == "nobody"
+func (c Collection) FindOr(pred any, defaultVal any) (any, error) {
+	match, elemType, err := c.find("FindOr", pred)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check to make sure the default value matches the slice element type.
+	fallback, ok := elemValue(defaultVal, elemType)
+	if !ok {
+		return nil, fmt.Errorf("FindOr() default value must be of type %s", elemType)
+	}
+
+	if !match.IsValid() {
+		return fallback.Interface(), nil
+	}
+
+	return match.Interface(), nil
+}
+
+// find validates pred and returns the first matching element along with the
+// element type of the slice. The returned element is the zero reflect.Value
+// when nothing matches.
+func (c Collection) find(name string, pred any) (reflect.Value, reflect.Type, error) {
+	if c.err != nil {
+		return reflect.Value{}, nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return reflect.Value{}, nil, errors.New("underlying data is not a slice")
+	}
+
+	fVal := reflect.ValueOf(pred)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure pred is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return reflect.Value{}, nil, fmt.Errorf("%s() function must take exactly one argument of type %s", name, elemType)
+	}
+
+	// Check to make sure pred returns a bool.
+	if fType.NumOut() != 1 || fType.Out(0).Kind() != reflect.Bool {
+		return reflect.Value{}, nil, fmt.Errorf("%s() function must return exactly one bool value", name)
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if fVal.Call([]reflect.Value{v.Index(i)})[0].Bool() {
+			return v.Index(i), elemType, nil
+		}
+	}
+
+	return reflect.Value{}, elemType, nil
+}
+
+// isNillableKind reports whether values of the given kind can be nil.
+func isNillableKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
+
+// elemValue converts value to a reflect.Value of elemType. A nil value becomes
+// the zero value of elemType when the element type can be nil. The returned
+// bool is false when value cannot be stored in an element of elemType.
+func elemValue(value any, elemType reflect.Type) (reflect.Value, bool) {
+	target := reflect.New(elemType).Elem()
+
+	val := reflect.ValueOf(value)
+	if !val.IsValid() {
+		return target, isNillableKind(elemType.Kind())
+	}
+
+	if !val.Type().AssignableTo(elemType) {
+		return reflect.Value{}, false
+	}
+
+	target.Set(val)
+
+	return target, true
+}
@@ -0,0 +1,212 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	t.Run("successful find", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			input         any
+			pred          any
+			expected      any
+			expectedFound bool
+		}{
+			{
+				name:          "first match is returned",
+				input:         []int{1, 4, 6, 7},
+				pred:          func(n int) bool { return n%2 == 0 },
+				expected:      4,
+				expectedFound: true,
+			},
+			{
+				name:          "no match",
+				input:         []string{"a", "b"},
+				pred:          func(s string) bool { return s == "z" },
+				expected:      nil,
+				expectedFound: false,
+			},
+			{
+				name:          "empty slice",
+				input:         []int{},
+				pred:          func(n int) bool { return true },
+				expected:      nil,
+				expectedFound: false,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, found, err := FromSlice(tt.input).Find(tt.pred)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if found != tt.expectedFound {
+					t.Errorf("expected found to be %v, got %v", tt.expectedFound, found)
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			pred     any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				pred:     func(n int) bool { return true },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "wrong argument type",
+				setup:    FromSlice([]int{1, 2}),
+				pred:     func(s string) bool { return true },
+				errorMsg: "Find() function must take exactly one argument of type int",
+			},
+			{
+				name:     "wrong return type",
+				setup:    FromSlice([]int{1, 2}),
+				pred:     func(n int) int { return n },
+				errorMsg: "Find() function must return exactly one bool value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := tt.setup.Find(tt.pred)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
+
+func TestFindOr(t *testing.T) {
+	t.Run("successful find", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			input      any
+			pred       any
+			defaultVal any
+			expected   any
+		}{
+			{
+				name:       "match ignores the default",
+				input:      []int{1, 4, 6},
+				pred:       func(n int) bool { return n > 3 },
+				defaultVal: -1,
+				expected:   4,
+			},
+			{
+				name:       "no match returns the default",
+				input:      []string{"ann", "bob"},
+				pred:       func(s string) bool { return strings.HasPrefix(s, "c") },
+				defaultVal: "nobody",
+				expected:   "nobody",
+			},
+			{
+				name:       "empty slice returns the default",
+				input:      []int{},
+				pred:       func(n int) bool { return true },
+				defaultVal: 7,
+				expected:   7,
+			},
+			{
+				name:       "nil default for interface element type",
+				input:      []any{1, "two"},
+				pred:       func(v any) bool { return v == 3 },
+				defaultVal: nil,
+				expected:   nil,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).FindOr(tt.pred, tt.defaultVal)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			setup      Collection
+			pred       any
+			defaultVal any
+			errorMsg   string
+		}{
+			{
+				name:       "collection with existing error",
+				setup:      Collection{data: nil, err: errors.New("existing error")},
+				pred:       func(n int) bool { return true },
+				defaultVal: 0,
+				errorMsg:   "existing error",
+			},
+			{
+				name:       "wrong argument type",
+				setup:      FromSlice([]int{1, 2}),
+				pred:       func(s string) bool { return true },
+				defaultVal: 0,
+				errorMsg:   "FindOr() function must take exactly one argument of type int",
+			},
+			{
+				name:       "wrong return type",
+				setup:      FromSlice([]int{1, 2}),
+				pred:       func(n int) int { return n },
+				defaultVal: 0,
+				errorMsg:   "FindOr() function must return exactly one bool value",
+			},
+			{
+				name:       "mismatched default type",
+				setup:      FromSlice([]int{1, 2}),
+				pred:       func(n int) bool { return n > 5 },
+				defaultVal: "none",
+				errorMsg:   "FindOr() default value must be of type int",
+			},
+			{
+				name:       "nil default for non-nillable element type",
+				setup:      FromSlice([]int{1, 2}),
+				pred:       func(n int) bool { return n > 5 },
+				defaultVal: nil,
+				errorMsg:   "FindOr() default value must be of type int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.FindOr(tt.pred, tt.defaultVal)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+)
+
+// FlattenDeep recursively flattens nested slices of any depth into a single
+// slice of the innermost element type, preserving order. For example a [][][]int
+// becomes a []int. A slice that is already flat is returned as a copy.
+//
+// The depth is determined by the static element type of the slice, so every
+// element is flattened to the same depth and irregular structures can't occur.
+// Elements of interface type (such as []any) are treated as leaves and are not
+// inspected, even if they hold slices.
+//
+// Example:
+//
+//	flat, err := FromSlice([][][]int{{{1, 2}, {3}}, {{4}}}).FlattenDeep()
+//	// flat == []int{1, 2, 3, 4}
+func (c Collection) FlattenDeep() (any, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	leafType := v.Type().Elem()
+	for leafType.Kind() == reflect.Slice {
+		leafType = leafType.Elem()
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(leafType), 0, v.Len())
+	result = flattenInto(result, v)
+
+	return result.Interface(), nil
+}
+
+// flattenInto appends the leaves of v to result, descending while v holds slices.
+func flattenInto(result, v reflect.Value) reflect.Value {
+	if v.Type().Elem().Kind() != reflect.Slice {
+		return reflect.AppendSlice(result, v)
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		result = flattenInto(result, v.Index(i))
+	}
+
+	return result
+}
@@ -0,0 +1,108 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFlattenDeep(t *testing.T) {
+	t.Run("successful flatten", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			expected any
+		}{
+			{
+				name:     "three levels of ints",
+				input:    [][][]int{{{1, 2}, {3}}, {{4}, {}}, {}},
+				expected: []int{1, 2, 3, 4},
+			},
+			{
+				name:     "two levels of strings",
+				input:    [][]string{{"a"}, {"b", "c"}},
+				expected: []string{"a", "b", "c"},
+			},
+			{
+				name:     "already flat",
+				input:    []int{1, 2, 3},
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "nil inner slices",
+				input:    [][]int{nil, {1}, nil},
+				expected: []int{1},
+			},
+			{
+				name:     "interface elements are leaves",
+				input:    [][]any{{1, []int{2, 3}}, {"a"}},
+				expected: []any{1, []int{2, 3}, "a"},
+			},
+			{
+				name:     "empty slice",
+				input:    [][][]int{},
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).FlattenDeep()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("already flat input is copied", func(t *testing.T) {
+		input := []int{1, 2, 3}
+		result, err := FromSlice(input).FlattenDeep()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		result.([]int)[0] = 100
+
+		if input[0] != 1 {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "underlying data is not a slice",
+				setup:    Collection{data: 42},
+				errorMsg: "underlying data is not a slice",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.FlattenDeep()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
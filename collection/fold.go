@@ -0,0 +1,42 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Fold performs a left fold over the Collection, starting from initial and
+// calling f with the accumulator and each element in order.
+//
+// It is a standalone generic function (not a method) due to Go's generic
+// limitations. Unlike Reduce, the accumulator is typed at compile time; only
+// the element is passed as 'any' and must be type-asserted inside f.
+//
+// Example:
+//
+//	total, err := Fold(FromSlice([]string{"a", "bb"}), func(acc int, e any) int {
+//	    return acc + len(e.(string))
+//	}, 0)
+//
+// This function will return an error if the Collection already contains an
+// error or its underlying data is not a slice.
+func Fold[R any](c Collection, f func(R, any) R, initial R) (R, error) {
+	var zero R
+
+	if c.err != nil {
+		return zero, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return zero, errors.New("underlying data is not a slice")
+	}
+
+	acc := initial
+
+	for i := 0; i < v.Len(); i++ {
+		acc = f(acc, v.Index(i).Interface())
+	}
+
+	return acc, nil
+}
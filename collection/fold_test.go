@@ -0,0 +1,113 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	t.Run("successful fold", func(t *testing.T) {
+		t.Run("strings.Builder concatenation", func(t *testing.T) {
+			builder, err := Fold(FromSlice([]string{"a", "b", "c"}), func(acc *strings.Builder, e any) *strings.Builder {
+				acc.WriteString(e.(string))
+				return acc
+			}, &strings.Builder{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if builder.String() != "abc" {
+				t.Errorf("expected %q, got %q", "abc", builder.String())
+			}
+		})
+
+		t.Run("struct accumulator", func(t *testing.T) {
+			type stats struct {
+				Count int
+				Sum   int
+				Evens []int
+			}
+
+			result, err := Fold(FromSlice([]int{1, 2, 3, 4}), func(acc stats, e any) stats {
+				n := e.(int)
+				acc.Count++
+				acc.Sum += n
+				if n%2 == 0 {
+					acc.Evens = append(acc.Evens, n)
+				}
+				return acc
+			}, stats{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := stats{Count: 4, Sum: 10, Evens: []int{2, 4}}
+			if !reflect.DeepEqual(result, expected) {
+				t.Errorf("expected %+v, got %+v", expected, result)
+			}
+		})
+
+		t.Run("folds left to right", func(t *testing.T) {
+			result, err := Fold(FromSlice([]int{1, 2, 3}), func(acc []int, e any) []int {
+				return append(acc, e.(int))
+			}, []int{0})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, []int{0, 1, 2, 3}) {
+				t.Errorf("expected %v, got %v", []int{0, 1, 2, 3}, result)
+			}
+		})
+
+		t.Run("empty collection returns initial", func(t *testing.T) {
+			result, err := Fold(FromSlice([]int{}), func(acc int, e any) int {
+				return acc + e.(int)
+			}, 42)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result != 42 {
+				t.Errorf("expected %d, got %d", 42, result)
+			}
+		})
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-slice data",
+				setup:    Collection{data: 42},
+				errorMsg: "underlying data is not a slice",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := Fold(tt.setup, func(acc int, e any) int { return acc + 1 }, 5)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+
+				if result != 0 {
+					t.Errorf("expected zero value, got %v", result)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+)
+
+// HeadTail splits the underlying slice into its first element and the
+// remaining elements.
+//
+// The head has the element type of the slice and the tail is a new slice of
+// the element type ([]T), so both can be type asserted directly. The tail is a
+// copy and is empty (not nil) when the slice holds a single element. An error
+// is returned if the Collection is empty.
+//
+// Example:
+//
+//	head, tail, err := FromSlice([]int{1, 2, 3}).HeadTail()
+//	// head == 1, tail == []int{2, 3}
+func (c Collection) HeadTail() (any, any, error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, nil, errors.New("underlying data is not a slice")
+	}
+
+	if v.Len() == 0 {
+		return nil, nil, errors.New("HeadTail() cannot be called on an empty collection")
+	}
+
+	tail := reflect.MakeSlice(v.Type(), v.Len()-1, v.Len()-1)
+	reflect.Copy(tail, v.Slice(1, v.Len()))
+
+	return v.Index(0).Interface(), tail.Interface(), nil
+}
@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHeadTail(t *testing.T) {
+	t.Run("successful split", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			input        any
+			expectedHead any
+			expectedTail any
+		}{
+			{
+				name:         "multiple elements",
+				input:        []int{1, 2, 3},
+				expectedHead: 1,
+				expectedTail: []int{2, 3},
+			},
+			{
+				name:         "single element has empty tail",
+				input:        []string{"only"},
+				expectedHead: "only",
+				expectedTail: []string{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				head, tail, err := FromSlice(tt.input).HeadTail()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if head != tt.expectedHead {
+					t.Errorf("expected head %v, got %v", tt.expectedHead, head)
+				}
+
+				if !reflect.DeepEqual(tail, tt.expectedTail) {
+					t.Errorf("expected tail %#v, got %#v", tt.expectedTail, tail)
+				}
+			})
+		}
+	})
+
+	t.Run("recursive processing", func(t *testing.T) {
+		var sum func(c Collection) int
+		sum = func(c Collection) int {
+			head, tail, err := c.HeadTail()
+			if err != nil {
+				return 0
+			}
+
+			return head.(int) + sum(FromSlice(tail))
+		}
+
+		if result := sum(FromSlice([]int{1, 2, 3, 4})); result != 10 {
+			t.Errorf("expected %d, got %d", 10, result)
+		}
+	})
+
+	t.Run("tail is a copy", func(t *testing.T) {
+		input := []int{1, 2, 3}
+		_, tail, err := FromSlice(input).HeadTail()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		tail.([]int)[0] = 100
+
+		if input[1] != 2 {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "empty collection",
+				setup:    FromSlice([]int{}),
+				errorMsg: "HeadTail() cannot be called on an empty collection",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := tt.setup.HeadTail()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
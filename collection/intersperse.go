@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Intersperse returns a new Collection with sep inserted between each pair of
+// adjacent elements. Empty and single-element slices are returned without any
+// separators.
+//
+// sep must be assignable to the element type of the slice. A nil sep is
+// accepted for element types that can be nil, such as pointers and interfaces.
+//
+// Example:
+//
+//	c := FromSlice([]int{1, 2, 3}).Intersperse(0)
+//	// c.ToSlice() == []int{1, 0, 2, 0, 3}
+func (c Collection) Intersperse(sep any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	elemType := v.Type().Elem()
+
+	// Check to make sure the separator matches the slice element type.
+	sepVal, ok := elemValue(sep, elemType)
+	if !ok {
+		return Collection{data: c.data, err: fmt.Errorf("Intersperse() value must be of type %s", elemType)}
+	}
+
+	length := max(2*v.Len()-1, 0)
+	resultSlice := reflect.MakeSlice(v.Type(), length, length)
+
+	for i := 0; i < v.Len(); i++ {
+		resultSlice.Index(2 * i).Set(v.Index(i))
+
+		if i > 0 {
+			resultSlice.Index(2*i - 1).Set(sepVal)
+		}
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
@@ -0,0 +1,116 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIntersperse(t *testing.T) {
+	t.Run("successful intersperse", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			sep      any
+			expected any
+		}{
+			{
+				name:     "multiple elements",
+				input:    []int{1, 2, 3},
+				sep:      0,
+				expected: []int{1, 0, 2, 0, 3},
+			},
+			{
+				name:     "two elements",
+				input:    []string{"a", "b"},
+				sep:      ",",
+				expected: []string{"a", ",", "b"},
+			},
+			{
+				name:     "single element",
+				input:    []int{1},
+				sep:      0,
+				expected: []int{1},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				sep:      0,
+				expected: []int{},
+			},
+			{
+				name:     "interface element type",
+				input:    []any{1, "a"},
+				sep:      true,
+				expected: []any{1, true, "a"},
+			},
+			{
+				name:     "nil separator for interface elements",
+				input:    []any{1, "a"},
+				sep:      nil,
+				expected: []any{1, nil, "a"},
+			},
+			{
+				name:     "nil separator for slice elements",
+				input:    [][]int{{1}, {2}},
+				sep:      nil,
+				expected: [][]int{{1}, nil, {2}},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Intersperse(tt.sep).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			sep      any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				sep:      0,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "separator type mismatch",
+				setup:    FromSlice([]int{1, 2}),
+				sep:      "0",
+				errorMsg: "Intersperse() value must be of type int",
+			},
+			{
+				name:     "nil separator",
+				setup:    FromSlice([]int{1, 2}),
+				sep:      nil,
+				errorMsg: "Intersperse() value must be of type int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Intersperse(tt.sep).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// KeyBy indexes the elements of the underlying slice by the key returned from
+// the provided function, producing a map[K]T. If several elements produce the
+// same key, the last one wins.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one comparable value (the key)
+//
+// If the key type is an interface such as any, every key must also hold a
+// comparable dynamic value. A key holding a slice, map or function results in
+// an error.
+//
+// Example:
+//
+//	byID, err := FromSlice(users).KeyBy(func(u User) int { return u.ID })
+//	// byID is a map[int]User
+func (c Collection) KeyBy(f any) (any, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return nil, fmt.Errorf("KeyBy() function must take exactly one argument of type %s", elemType)
+	}
+
+	// Check to make sure f returns one comparable key.
+	if fType.NumOut() != 1 || !fType.Out(0).Comparable() {
+		return nil, errors.New("KeyBy() function must return exactly one comparable value")
+	}
+
+	result := reflect.MakeMapWithSize(reflect.MapOf(fType.Out(0), elemType), v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		key := fVal.Call([]reflect.Value{v.Index(i)})[0]
+
+		// Check to make sure the dynamic key can be hashed, which matters for interface key types.
+		if !key.Comparable() {
+			return nil, fmt.Errorf("KeyBy() key at index %d is not comparable. Got %s", i, dynamicType(key))
+		}
+
+		result.SetMapIndex(key, v.Index(i))
+	}
+
+	return result.Interface(), nil
+}
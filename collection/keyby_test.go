@@ -0,0 +1,134 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type keyByUser struct {
+	ID   int
+	Name string
+}
+
+func TestKeyBy(t *testing.T) {
+	t.Run("successful keying", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			f        any
+			expected any
+		}{
+			{
+				name: "structs by ID",
+				input: []keyByUser{
+					{ID: 1, Name: "Alice"},
+					{ID: 2, Name: "Bob"},
+				},
+				f: func(u keyByUser) int { return u.ID },
+				expected: map[int]keyByUser{
+					1: {ID: 1, Name: "Alice"},
+					2: {ID: 2, Name: "Bob"},
+				},
+			},
+			{
+				name: "later collisions overwrite",
+				input: []keyByUser{
+					{ID: 1, Name: "Alice"},
+					{ID: 2, Name: "Bob"},
+					{ID: 1, Name: "Carol"},
+				},
+				f: func(u keyByUser) int { return u.ID },
+				expected: map[int]keyByUser{
+					1: {ID: 1, Name: "Carol"},
+					2: {ID: 2, Name: "Bob"},
+				},
+			},
+			{
+				name:     "strings by length",
+				input:    []string{"a", "bb", "c"},
+				f:        func(s string) int { return len(s) },
+				expected: map[int]string{1: "c", 2: "bb"},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				f:        func(n int) int { return n },
+				expected: map[int]int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).KeyBy(tt.f)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			f        any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				f:        func(n int) int { return n },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        "not a function",
+				errorMsg: "KeyBy() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with wrong argument type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(s string) string { return s },
+				errorMsg: "KeyBy() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function returns non-comparable key",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(n int) []int { return []int{n} },
+				errorMsg: "KeyBy() function must return exactly one comparable value",
+			},
+			{
+				name:     "interface key holding a non-comparable value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(n int) any { return []int{n} },
+				errorMsg: "KeyBy() key at index 0 is not comparable. Got []int",
+			},
+			{
+				name:     "function returns nothing",
+				setup:    FromSlice([]int{1, 2, 3}),
+				f:        func(n int) {},
+				errorMsg: "KeyBy() function must return exactly one comparable value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.KeyBy(tt.f)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
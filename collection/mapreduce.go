@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// MapReduce maps each element of the underlying slice with mapFn and folds the
+// mapped values into a single result with reduceFn, starting from initial.
+//
+// Unlike chaining Map and Reduce, no intermediate slice is materialized; each
+// element is mapped and immediately folded into the accumulator.
+//
+// The map function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one value (the mapped element)
+//
+// The reduce function must:
+//   - Be a function type
+//   - Take two arguments: (accumulator, mapped), where the accumulator type matches the type of 'initial'
+//     and the second argument matches the map function's return type
+//   - Return exactly one value, which must match the accumulator type
+//
+// Example:
+//
+//	total, err := FromSlice([]int{1, 22, 333}).MapReduce(
+//	    func(n int) string { return strconv.Itoa(n) },
+//	    func(acc int, s string) int { return acc + len(s) },
+//	    0,
+//	)
+func (c Collection) MapReduce(mapFn, reduceFn, initial any) (any, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	mapVal := reflect.ValueOf(mapFn)
+	mapType := mapVal.Type()
+	elemType := v.Type().Elem()
+
+	if mapType.Kind() != reflect.Func || mapType.NumIn() != 1 || !mapType.In(0).AssignableTo(elemType) {
+		return nil, fmt.Errorf("MapReduce() map function must take exactly one argument of type %s", elemType)
+	}
+
+	if mapType.NumOut() != 1 {
+		return nil, errors.New("MapReduce() map function must return exactly one value")
+	}
+
+	mappedType := mapType.Out(0)
+
+	reduceVal := reflect.ValueOf(reduceFn)
+	reduceType := reduceVal.Type()
+	initialVal := reflect.ValueOf(initial)
+	initialType := initialVal.Type()
+
+	if reduceType.Kind() != reflect.Func ||
+		reduceType.NumIn() != 2 ||
+		!reduceType.In(0).AssignableTo(initialType) ||
+		!mappedType.AssignableTo(reduceType.In(1)) {
+		return nil, fmt.Errorf("MapReduce() reduce function must take two arguments. First of type %s. Second of type %s.", initialType, mappedType)
+	}
+
+	if reduceType.NumOut() != 1 || !reduceType.Out(0).AssignableTo(initialType) {
+		return nil, fmt.Errorf("MapReduce() reduce function must return exactly one element of type %s", initialType)
+	}
+
+	acc := initialVal
+
+	for i := 0; i < v.Len(); i++ {
+		mapped := mapVal.Call([]reflect.Value{v.Index(i)})[0]
+		acc = reduceVal.Call([]reflect.Value{acc, mapped})[0]
+	}
+
+	return acc.Interface(), nil
+}
@@ -0,0 +1,149 @@
+package collection
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapReduce(t *testing.T) {
+	t.Run("successful map reduce", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			input      any
+			mapFunc    any
+			reduceFunc any
+			initial    any
+			expected   any
+		}{
+			{
+				name:       "total length of mapped strings",
+				input:      []int{1, 22, 333},
+				mapFunc:    func(n int) string { return strconv.Itoa(n) },
+				reduceFunc: func(acc int, s string) int { return acc + len(s) },
+				initial:    0,
+				expected:   6,
+			},
+			{
+				name:       "sum of squares",
+				input:      []int{1, 2, 3},
+				mapFunc:    func(n int) int { return n * n },
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    0,
+				expected:   14,
+			},
+			{
+				name:       "concatenate mapped strings",
+				input:      []string{"a", "b", "c"},
+				mapFunc:    func(s string) string { return strings.ToUpper(s) },
+				reduceFunc: func(acc, s string) string { return acc + s },
+				initial:    "",
+				expected:   "ABC",
+			},
+			{
+				name:       "empty slice",
+				input:      []int{},
+				mapFunc:    func(n int) int { return n * n },
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    42,
+				expected:   42,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).MapReduce(tt.mapFunc, tt.reduceFunc, tt.initial)
+
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			setup      Collection
+			mapFunc    any
+			reduceFunc any
+			initial    any
+			errorMsg   string
+		}{
+			{
+				name:       "collection with existing error",
+				setup:      Collection{data: nil, err: errors.New("existing error")},
+				mapFunc:    func(n int) int { return n },
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    0,
+				errorMsg:   "existing error",
+			},
+			{
+				name:       "map function is not a function",
+				setup:      FromSlice([]int{1, 2, 3}),
+				mapFunc:    "not a function",
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    0,
+				errorMsg:   "MapReduce() map function must take exactly one argument of type int",
+			},
+			{
+				name:       "map function with wrong input type",
+				setup:      FromSlice([]int{1, 2, 3}),
+				mapFunc:    func(s string) int { return len(s) },
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    0,
+				errorMsg:   "MapReduce() map function must take exactly one argument of type int",
+			},
+			{
+				name:       "map function with no return",
+				setup:      FromSlice([]int{1, 2, 3}),
+				mapFunc:    func(n int) {},
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    0,
+				errorMsg:   "MapReduce() map function must return exactly one value",
+			},
+			{
+				name:       "reduce function with mismatched mapped type",
+				setup:      FromSlice([]int{1, 2, 3}),
+				mapFunc:    func(n int) string { return strconv.Itoa(n) },
+				reduceFunc: func(acc, n int) int { return acc + n },
+				initial:    0,
+				errorMsg:   "MapReduce() reduce function must take two arguments. First of type int. Second of type string.",
+			},
+			{
+				name:       "reduce function with mismatched accumulator type",
+				setup:      FromSlice([]int{1, 2, 3}),
+				mapFunc:    func(n int) int { return n },
+				reduceFunc: func(acc string, n int) string { return acc },
+				initial:    0,
+				errorMsg:   "MapReduce() reduce function must take two arguments. First of type int. Second of type int.",
+			},
+			{
+				name:       "reduce function with wrong return type",
+				setup:      FromSlice([]int{1, 2, 3}),
+				mapFunc:    func(n int) int { return n },
+				reduceFunc: func(acc, n int) string { return "wrong" },
+				initial:    0,
+				errorMsg:   "MapReduce() reduce function must return exactly one element of type int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.MapReduce(tt.mapFunc, tt.reduceFunc, tt.initial)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
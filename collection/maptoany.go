@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var anyType = reflect.TypeOf((*any)(nil)).Elem()
+
+// MapToAny applies the provided function to each element of the underlying
+// slice and returns a new Collection holding the results as a []any.
+//
+// Unlike Map, which infers a single output type from the function, MapToAny
+// is meant for functions whose results differ in type from element to element,
+// such as values prepared for templating.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one value of type any
+//
+// Example:
+//
+//	c := FromSlice([]int{1, 2, 3}).MapToAny(func(n int) any {
+//	    if n%2 == 0 {
+//	        return "even"
+//	    }
+//	    return n
+//	})
+//	// c.ToSlice() == []any{1, "even", 3}
+func (c Collection) MapToAny(f any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fVal.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("MapToAny() function must take exactly one argument of type %s", elemType)}
+	}
+
+	// Check to make sure f returns a single any value.
+	if fType.NumOut() != 1 || fType.Out(0) != anyType {
+		return Collection{data: c.data, err: errors.New("MapToAny() function must return exactly one value of type any")}
+	}
+
+	result := make([]any, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		result[i] = fVal.Call([]reflect.Value{v.Index(i)})[0].Interface()
+	}
+
+	return Collection{data: result, err: nil}
+}
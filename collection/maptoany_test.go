@@ -0,0 +1,134 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapToAny(t *testing.T) {
+	t.Run("successful map", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			f        any
+			expected []any
+		}{
+			{
+				name:  "mixed string and int results",
+				input: []int{1, 2, 3, 4},
+				f: func(n int) any {
+					if n%2 == 0 {
+						return strconv.Itoa(n)
+					}
+					return n
+				},
+				expected: []any{1, "2", 3, "4"},
+			},
+			{
+				name:     "nil results",
+				input:    []string{"a", ""},
+				f:        func(s string) any { return nil },
+				expected: []any{nil, nil},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				f:        func(n int) any { return n },
+				expected: []any{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).MapToAny(tt.f).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("downstream ForEach sees heterogeneous values", func(t *testing.T) {
+		var kinds []reflect.Kind
+
+		err := FromSlice([]int{1, 2}).
+			MapToAny(func(n int) any {
+				if n == 1 {
+					return "one"
+				}
+				return n
+			}).
+			TryForEach(func(v any) error {
+				kinds = append(kinds, reflect.TypeOf(v).Kind())
+				return nil
+			})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []reflect.Kind{reflect.String, reflect.Int}
+		if !reflect.DeepEqual(kinds, expected) {
+			t.Errorf("expected %v, got %v", expected, kinds)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			f        any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				f:        func(n int) any { return n },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-slice data",
+				setup:    Collection{data: 42},
+				f:        func(n int) any { return n },
+				errorMsg: "underlying data is not a slice",
+			},
+			{
+				name:     "wrong argument type",
+				setup:    FromSlice([]int{1, 2}),
+				f:        func(s string) any { return s },
+				errorMsg: "MapToAny() function must take exactly one argument of type int",
+			},
+			{
+				name:     "concrete return type",
+				setup:    FromSlice([]int{1, 2}),
+				f:        func(n int) string { return strconv.Itoa(n) },
+				errorMsg: "MapToAny() function must return exactly one value of type any",
+			},
+			{
+				name:     "multiple return values",
+				setup:    FromSlice([]int{1, 2}),
+				f:        func(n int) (any, error) { return n, nil },
+				errorMsg: "MapToAny() function must return exactly one value of type any",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.MapToAny(tt.f).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
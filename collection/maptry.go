@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// MapTry applies the provided fallible function to each element of the
+// underlying slice in order, returning a new Collection with the transformed
+// elements.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly two values: the transformed element and an error
+//
+// Processing stops at the first element for which the function returns a
+// non-nil error. The returned Collection then carries an error that wraps the
+// function's error and includes the index of the element that caused it, so
+// errors.Is and errors.As work on the result.
+//
+// Example:
+//
+//	nums, err := FromSlice([]string{"1", "2", "3"}).MapTry(strconv.Atoi).ToSlice()
+func (c Collection) MapTry(f any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("MapTry() function must take exactly one argument of type %s", elemType)}
+	}
+
+	// Check to make sure f returns a value and an error.
+	if fType.NumOut() != 2 || fType.Out(1) != errorType {
+		return Collection{data: c.data, err: errors.New("MapTry() function must return exactly one value and one error")}
+	}
+
+	resultSlice := reflect.MakeSlice(reflect.SliceOf(fType.Out(0)), v.Len(), v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		out := fVal.Call([]reflect.Value{v.Index(i)})
+		if !out[1].IsNil() {
+			return Collection{data: c.data, err: fmt.Errorf("MapTry() failed at index %d: %w", i, out[1].Interface().(error))}
+		}
+
+		resultSlice.Index(i).Set(out[0])
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
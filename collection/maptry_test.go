@@ -0,0 +1,124 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapTry(t *testing.T) {
+	t.Run("function never errors", func(t *testing.T) {
+		result, err := FromSlice([]string{"1", "2", "3"}).MapTry(strconv.Atoi).ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("expected %v, got %v", []int{1, 2, 3}, result)
+		}
+	})
+
+	t.Run("function errors on specific element", func(t *testing.T) {
+		errBad := errors.New("bad element")
+		var visited []int
+
+		c := FromSlice([]int{1, 2, 3, 4}).MapTry(func(n int) (string, error) {
+			visited = append(visited, n)
+			if n == 3 {
+				return "", errBad
+			}
+			return strconv.Itoa(n), nil
+		})
+
+		mapped := false
+		_, err := c.Map(func(n int) int {
+			mapped = true
+			return n
+		}).ToSlice()
+
+		if err == nil {
+			t.Fatal("expected error but got none")
+		}
+
+		if !errors.Is(err, errBad) {
+			t.Errorf("expected error to wrap %v, got %v", errBad, err)
+		}
+
+		if !strings.Contains(err.Error(), "index 2") {
+			t.Errorf("expected error to mention index 2, got %q", err.Error())
+		}
+
+		if len(visited) != 3 {
+			t.Errorf("expected processing to stop after 3 elements, visited %v", visited)
+		}
+
+		if mapped {
+			t.Error("expected the rest of the chain to be skipped")
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result, err := FromSlice([]string{}).MapTry(strconv.Atoi).ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(result, []int{}) {
+			t.Errorf("expected %v, got %v", []int{}, result)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			function any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				function: strconv.Atoi,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: "not a function",
+				errorMsg: "MapTry() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with wrong input type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: strconv.Atoi,
+				errorMsg: "MapTry() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function returns one value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: func(n int) int { return n },
+				errorMsg: "MapTry() function must return exactly one value and one error",
+			},
+			{
+				name:     "second return value is not an error",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: func(n int) (int, bool) { return n, true },
+				errorMsg: "MapTry() function must return exactly one value and one error",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.MapTry(tt.function).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
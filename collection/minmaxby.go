@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// MaxBy returns the greatest element of the underlying slice according to the
+// provided less function. If several elements are equally great, the first one
+// is returned.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take two arguments, both matching the element type of the slice
+//   - Return exactly one bool value, reporting whether the first argument is less than the second
+//
+// An error is returned if the Collection is empty.
+//
+// Example:
+//
+//	oldest, err := FromSlice(people).MaxBy(func(a, b Person) bool { return a.Age < b.Age })
+func (c Collection) MaxBy(less any) (any, error) {
+	return c.extremeBy("MaxBy", less, true)
+}
+
+// MinBy returns the smallest element of the underlying slice according to the
+// provided less function. If several elements are equally small, the first one
+// is returned.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take two arguments, both matching the element type of the slice
+//   - Return exactly one bool value, reporting whether the first argument is less than the second
+//
+// An error is returned if the Collection is empty.
+//
+// Example:
+//
+//	youngest, err := FromSlice(people).MinBy(func(a, b Person) bool { return a.Age < b.Age })
+func (c Collection) MinBy(less any) (any, error) {
+	return c.extremeBy("MinBy", less, false)
+}
+
+// extremeBy implements MaxBy and MinBy. The name is used in error messages.
+func (c Collection) extremeBy(name string, less any, max bool) (any, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	fVal := reflect.ValueOf(less)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	if fType.Kind() != reflect.Func ||
+		fType.NumIn() != 2 ||
+		!fType.In(0).AssignableTo(elemType) ||
+		!fType.In(1).AssignableTo(elemType) {
+		return nil, fmt.Errorf("%s() function must take exactly two arguments of type %s", name, elemType)
+	}
+
+	if fType.NumOut() != 1 || fType.Out(0).Kind() != reflect.Bool {
+		return nil, fmt.Errorf("%s() function must return exactly one bool value", name)
+	}
+
+	if v.Len() == 0 {
+		return nil, fmt.Errorf("%s() cannot be called on an empty collection", name)
+	}
+
+	result := v.Index(0)
+
+	for i := 1; i < v.Len(); i++ {
+		elem := v.Index(i)
+
+		var replace bool
+		if max {
+			replace = fVal.Call([]reflect.Value{result, elem})[0].Bool()
+		} else {
+			replace = fVal.Call([]reflect.Value{elem, result})[0].Bool()
+		}
+
+		if replace {
+			result = elem
+		}
+	}
+
+	return result.Interface(), nil
+}
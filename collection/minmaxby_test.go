@@ -0,0 +1,212 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type minMaxPerson struct {
+	Name string
+	Age  int
+}
+
+var minMaxPeople = []minMaxPerson{
+	{Name: "Alice", Age: 30},
+	{Name: "Bob", Age: 25},
+	{Name: "Carol", Age: 35},
+	{Name: "Dave", Age: 35},
+	{Name: "Eve", Age: 25},
+}
+
+func TestMaxBy(t *testing.T) {
+	t.Run("successful max", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			lessFunc any
+			expected any
+		}{
+			{
+				name:     "structs by field with tie returns first",
+				input:    minMaxPeople,
+				lessFunc: func(a, b minMaxPerson) bool { return a.Age < b.Age },
+				expected: minMaxPerson{Name: "Carol", Age: 35},
+			},
+			{
+				name:     "structs by name",
+				input:    minMaxPeople,
+				lessFunc: func(a, b minMaxPerson) bool { return a.Name < b.Name },
+				expected: minMaxPerson{Name: "Eve", Age: 25},
+			},
+			{
+				name:     "single element",
+				input:    []int{42},
+				lessFunc: func(a, b int) bool { return a < b },
+				expected: 42,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).MaxBy(tt.lessFunc)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			lessFunc any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				lessFunc: func(a, b int) bool { return a < b },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: "not a function",
+				errorMsg: "MaxBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function with one argument",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a int) bool { return a > 0 },
+				errorMsg: "MaxBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function with wrong argument type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a, b string) bool { return a < b },
+				errorMsg: "MaxBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function returns non-bool",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a, b int) int { return a - b },
+				errorMsg: "MaxBy() function must return exactly one bool value",
+			},
+			{
+				name:     "empty collection",
+				setup:    FromSlice([]int{}),
+				lessFunc: func(a, b int) bool { return a < b },
+				errorMsg: "MaxBy() cannot be called on an empty collection",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.MaxBy(tt.lessFunc)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
+
+func TestMinBy(t *testing.T) {
+	t.Run("successful min", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			lessFunc any
+			expected any
+		}{
+			{
+				name:     "structs by field with tie returns first",
+				input:    minMaxPeople,
+				lessFunc: func(a, b minMaxPerson) bool { return a.Age < b.Age },
+				expected: minMaxPerson{Name: "Bob", Age: 25},
+			},
+			{
+				name:     "structs by name",
+				input:    minMaxPeople,
+				lessFunc: func(a, b minMaxPerson) bool { return a.Name < b.Name },
+				expected: minMaxPerson{Name: "Alice", Age: 30},
+			},
+			{
+				name:     "single element",
+				input:    []int{42},
+				lessFunc: func(a, b int) bool { return a < b },
+				expected: 42,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).MinBy(tt.lessFunc)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if result != tt.expected {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			lessFunc any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				lessFunc: func(a, b int) bool { return a < b },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "function with wrong argument type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a, b string) bool { return a < b },
+				errorMsg: "MinBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function returns nothing",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a, b int) {},
+				errorMsg: "MinBy() function must return exactly one bool value",
+			},
+			{
+				name:     "empty collection",
+				setup:    FromSlice([]int{}),
+				lessFunc: func(a, b int) bool { return a < b },
+				errorMsg: "MinBy() cannot be called on an empty collection",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.MinBy(tt.lessFunc)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
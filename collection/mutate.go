@@ -0,0 +1,60 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Mutate calls the provided function with a pointer to each element, allowing
+// the function to modify the elements in place, and returns a new Collection
+// holding the modified elements.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument that is a pointer to the element type of the slice
+//   - Return no value
+//
+// The elements are copied into a new slice before f is called, so the
+// underlying slice of the original Collection is never modified. Note that
+// the copy is shallow: changes made through pointers, maps or slices held by
+// an element are still visible from the original slice.
+//
+// Example:
+//
+//	c := FromSlice(users).Mutate(func(u *User) {
+//	    u.Name = strings.ToUpper(u.Name)
+//	})
+func (c Collection) Mutate(f any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	ptrType := reflect.PointerTo(v.Type().Elem())
+
+	// Check to make sure f is a function that takes one pointer to the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !ptrType.AssignableTo(fType.In(0)) {
+		return Collection{data: c.data, err: fmt.Errorf("Mutate() function must take exactly one argument of type %s", ptrType)}
+	}
+
+	// Check to make sure that f doesn't return anything.
+	if fType.NumOut() != 0 {
+		return Collection{data: c.data, err: errors.New("Mutate() function cannot return anything")}
+	}
+
+	resultSlice := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(resultSlice, v)
+
+	for i := 0; i < resultSlice.Len(); i++ {
+		fVal.Call([]reflect.Value{resultSlice.Index(i).Addr()})
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
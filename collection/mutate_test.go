@@ -0,0 +1,125 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMutate(t *testing.T) {
+	type user struct {
+		Name  string
+		Score int
+	}
+
+	t.Run("successful mutation", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			fn       any
+			expected any
+		}{
+			{
+				name:  "mutate struct fields",
+				input: []user{{"alice", 1}, {"bob", 2}},
+				fn: func(u *user) {
+					u.Name = strings.ToUpper(u.Name)
+					u.Score *= 10
+				},
+				expected: []user{{"ALICE", 10}, {"BOB", 20}},
+			},
+			{
+				name:     "mutate ints",
+				input:    []int{1, 2, 3},
+				fn:       func(n *int) { *n = *n * *n },
+				expected: []int{1, 4, 9},
+			},
+			{
+				name:     "empty slice",
+				input:    []user{},
+				fn:       func(u *user) { u.Score++ },
+				expected: []user{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Mutate(tt.fn).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("original slice is not modified", func(t *testing.T) {
+		input := []user{{"alice", 1}}
+
+		_, err := FromSlice(input).Mutate(func(u *user) { u.Score = 100 }).ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if input[0].Score != 1 {
+			t.Errorf("expected original score %d, got %d", 1, input[0].Score)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			fn       any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				fn:       func(n *int) {},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-function argument",
+				setup:    FromSlice([]int{1, 2}),
+				fn:       42,
+				errorMsg: "Mutate() function must take exactly one argument of type *int",
+			},
+			{
+				name:     "value instead of pointer argument",
+				setup:    FromSlice([]int{1, 2}),
+				fn:       func(n int) {},
+				errorMsg: "Mutate() function must take exactly one argument of type *int",
+			},
+			{
+				name:     "pointer to wrong type",
+				setup:    FromSlice([]int{1, 2}),
+				fn:       func(s *string) {},
+				errorMsg: "Mutate() function must take exactly one argument of type *int",
+			},
+			{
+				name:     "function returns a value",
+				setup:    FromSlice([]int{1, 2}),
+				fn:       func(n *int) int { return *n },
+				errorMsg: "Mutate() function cannot return anything",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Mutate(tt.fn).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package collection
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Sorter sorts a Collection by one or more keys. It is created with
+// Collection.OrderBy, extended with ThenBy, and finished with Collection or ToSlice.
+//
+// Elements are compared by their first key; ties are broken by the next key,
+// and so on. Elements that compare equal on every key keep their original order.
+type Sorter struct {
+	c      Collection
+	keyFns []reflect.Value
+	err    error
+}
+
+// OrderBy starts a multi-key sort of the underlying slice, using the value
+// returned by keyFn as the primary sort key in ascending order.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one ordered value (an integer, float or string type)
+//
+// Example:
+//
+//	sorted, err := FromSlice(people).
+//	    OrderBy(func(p Person) string { return p.LastName }).
+//	    ThenBy(func(p Person) string { return p.FirstName }).
+//	    ToSlice()
+func (c Collection) OrderBy(keyFn any) *Sorter {
+	return (&Sorter{c: c, err: c.err}).addKey("OrderBy", keyFn)
+}
+
+// ThenBy adds another sort key in ascending order, used to break ties left by
+// the previous keys. The key function follows the same rules as in OrderBy.
+//
+// ThenBy returns a new Sorter and leaves s unchanged, so several orderings can
+// be derived from the same base Sorter.
+func (s *Sorter) ThenBy(keyFn any) *Sorter {
+	return s.addKey("ThenBy", keyFn)
+}
+
+// Collection sorts a copy of the underlying slice by the configured keys and
+// returns it as a new Collection. The underlying slice is not modified.
+func (s *Sorter) Collection() Collection {
+	if s.err != nil {
+		return Collection{data: s.c.data, err: s.err}
+	}
+
+	v := reflect.ValueOf(s.c.data)
+
+	// Compute every key once up front rather than on every comparison.
+	keys := make([][]reflect.Value, v.Len())
+	for i := range keys {
+		keys[i] = make([]reflect.Value, len(s.keyFns))
+		for k, keyFn := range s.keyFns {
+			keys[i][k] = keyFn.Call([]reflect.Value{v.Index(i)})[0]
+		}
+	}
+
+	order := make([]int, v.Len())
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		for k := range s.keyFns {
+			if result := compareOrdered(keys[order[a]][k], keys[order[b]][k]); result != 0 {
+				return result < 0
+			}
+		}
+
+		return false
+	})
+
+	resultSlice := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	for i, index := range order {
+		resultSlice.Index(i).Set(v.Index(index))
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
+
+// ToSlice sorts the underlying slice by the configured keys and returns the
+// sorted copy, or the first error encountered while building the Sorter.
+func (s *Sorter) ToSlice() (any, error) {
+	return s.Collection().ToSlice()
+}
+
+// addKey validates keyFn and returns a new Sorter with keyFn appended to a copy
+// of the Sorter's keys. The name is used in error messages.
+func (s *Sorter) addKey(name string, keyFn any) *Sorter {
+	if s.err != nil {
+		return s
+	}
+
+	next := &Sorter{c: s.c}
+
+	v := reflect.ValueOf(s.c.data)
+	if v.Kind() != reflect.Slice {
+		next.err = errors.New("underlying data is not a slice")
+		return next
+	}
+
+	fVal := reflect.ValueOf(keyFn)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure keyFn is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		next.err = fmt.Errorf("%s() function must take exactly one argument of type %s", name, elemType)
+		return next
+	}
+
+	// Check to make sure keyFn returns one ordered key.
+	if fType.NumOut() != 1 || !isOrderedKind(fType.Out(0).Kind()) {
+		next.err = fmt.Errorf("%s() function must return exactly one ordered value", name)
+		return next
+	}
+
+	next.keyFns = make([]reflect.Value, len(s.keyFns), len(s.keyFns)+1)
+	copy(next.keyFns, s.keyFns)
+	next.keyFns = append(next.keyFns, fVal)
+
+	return next
+}
+
+// isOrderedKind reports whether values of the given kind support the < operator.
+func isOrderedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareOrdered compares two values of the same ordered kind, returning -1, 0
+// or +1 like cmp.Compare.
+func compareOrdered(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	default:
+		return cmp.Compare(a.String(), b.String())
+	}
+}
@@ -0,0 +1,196 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type orderByEmployee struct {
+	Department string
+	Name       string
+	Salary     float64
+}
+
+var orderByEmployees = []orderByEmployee{
+	{Department: "Sales", Name: "Carol", Salary: 50},
+	{Department: "Engineering", Name: "Bob", Salary: 70},
+	{Department: "Sales", Name: "Alice", Salary: 60},
+	{Department: "Engineering", Name: "Alice", Salary: 80},
+	{Department: "Engineering", Name: "Bob", Salary: 65},
+}
+
+func TestOrderBy(t *testing.T) {
+	byDepartment := func(e orderByEmployee) string { return e.Department }
+	byName := func(e orderByEmployee) string { return e.Name }
+	bySalary := func(e orderByEmployee) float64 { return e.Salary }
+
+	t.Run("successful sort", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			sorter   *Sorter
+			expected any
+		}{
+			{
+				name:   "single key keeps ties in original order",
+				sorter: FromSlice(orderByEmployees).OrderBy(byDepartment),
+				expected: []orderByEmployee{
+					{Department: "Engineering", Name: "Bob", Salary: 70},
+					{Department: "Engineering", Name: "Alice", Salary: 80},
+					{Department: "Engineering", Name: "Bob", Salary: 65},
+					{Department: "Sales", Name: "Carol", Salary: 50},
+					{Department: "Sales", Name: "Alice", Salary: 60},
+				},
+			},
+			{
+				name:   "primary then secondary key",
+				sorter: FromSlice(orderByEmployees).OrderBy(byDepartment).ThenBy(byName),
+				expected: []orderByEmployee{
+					{Department: "Engineering", Name: "Alice", Salary: 80},
+					{Department: "Engineering", Name: "Bob", Salary: 70},
+					{Department: "Engineering", Name: "Bob", Salary: 65},
+					{Department: "Sales", Name: "Alice", Salary: 60},
+					{Department: "Sales", Name: "Carol", Salary: 50},
+				},
+			},
+			{
+				name:   "three keys",
+				sorter: FromSlice(orderByEmployees).OrderBy(byDepartment).ThenBy(byName).ThenBy(bySalary),
+				expected: []orderByEmployee{
+					{Department: "Engineering", Name: "Alice", Salary: 80},
+					{Department: "Engineering", Name: "Bob", Salary: 65},
+					{Department: "Engineering", Name: "Bob", Salary: 70},
+					{Department: "Sales", Name: "Alice", Salary: 60},
+					{Department: "Sales", Name: "Carol", Salary: 50},
+				},
+			},
+			{
+				name:     "unsigned and negated keys",
+				sorter:   FromSlice([]uint{3, 1, 2}).OrderBy(func(n uint) int { return -int(n) }),
+				expected: []uint{3, 2, 1},
+			},
+			{
+				name:     "empty slice",
+				sorter:   FromSlice([]int{}).OrderBy(func(n int) int { return n }),
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.sorter.ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("chains back into a Collection", func(t *testing.T) {
+		result, err := FromSlice(orderByEmployees).
+			OrderBy(bySalary).
+			Collection().
+			Map(func(e orderByEmployee) float64 { return e.Salary }).
+			ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(result, []float64{50, 60, 65, 70, 80}) {
+			t.Errorf("expected %v, got %v", []float64{50, 60, 65, 70, 80}, result)
+		}
+	})
+
+	t.Run("orderings derived from one base are independent", func(t *testing.T) {
+		salaries := func(s *Sorter) any {
+			result, err := s.Collection().Map(func(e orderByEmployee) float64 { return e.Salary }).ToSlice()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			return result
+		}
+
+		base := FromSlice(orderByEmployees).OrderBy(byDepartment)
+		withName := base.ThenBy(byName)
+		withSalary := base.ThenBy(bySalary)
+
+		if result := salaries(withName); !reflect.DeepEqual(result, []float64{80, 70, 65, 60, 50}) {
+			t.Errorf("expected %v, got %v", []float64{80, 70, 65, 60, 50}, result)
+		}
+
+		if result := salaries(withSalary); !reflect.DeepEqual(result, []float64{65, 70, 80, 50, 60}) {
+			t.Errorf("expected %v, got %v", []float64{65, 70, 80, 50, 60}, result)
+		}
+
+		if result := salaries(base); !reflect.DeepEqual(result, []float64{70, 80, 65, 50, 60}) {
+			t.Errorf("expected %v, got %v", []float64{70, 80, 65, 50, 60}, result)
+		}
+	})
+
+	t.Run("original slice is not modified", func(t *testing.T) {
+		input := []int{3, 1, 2}
+		_, _ = FromSlice(input).OrderBy(func(n int) int { return n }).ToSlice()
+
+		if !reflect.DeepEqual(input, []int{3, 1, 2}) {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			sorter   *Sorter
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				sorter:   Collection{data: nil, err: errors.New("existing error")}.OrderBy(byName),
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				sorter:   FromSlice(orderByEmployees).OrderBy("not a function"),
+				errorMsg: "OrderBy() function must take exactly one argument of type collection.orderByEmployee",
+			},
+			{
+				name:     "function with wrong argument type",
+				sorter:   FromSlice(orderByEmployees).OrderBy(func(n int) int { return n }),
+				errorMsg: "OrderBy() function must take exactly one argument of type collection.orderByEmployee",
+			},
+			{
+				name:     "key is not ordered",
+				sorter:   FromSlice(orderByEmployees).OrderBy(func(e orderByEmployee) bool { return e.Salary > 60 }),
+				errorMsg: "OrderBy() function must return exactly one ordered value",
+			},
+			{
+				name:     "invalid ThenBy",
+				sorter:   FromSlice(orderByEmployees).OrderBy(byName).ThenBy(func(e orderByEmployee) []string { return nil }),
+				errorMsg: "ThenBy() function must return exactly one ordered value",
+			},
+			{
+				name:     "first error is kept",
+				sorter:   FromSlice(orderByEmployees).OrderBy("bad").ThenBy("worse"),
+				errorMsg: "OrderBy() function must take exactly one argument",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.sorter.ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
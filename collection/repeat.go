@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Repeat returns a new Collection whose underlying slice is the current slice
+// concatenated with itself the given number of times. The order of the
+// elements within each repetition is preserved.
+//
+// If times is less than or equal to zero, the resulting Collection holds an
+// empty slice of the same element type. It returns an error if the resulting
+// length would overflow an int.
+//
+// Example:
+//
+//	c := FromSlice([]int{1, 2}).Repeat(3)
+//	// c.ToSlice() == []int{1, 2, 1, 2, 1, 2}
+func (c Collection) Repeat(times int) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	times = max(times, 0)
+
+	// Check to make sure the result length fits in an int.
+	if times > 0 && v.Len() > math.MaxInt/times {
+		return Collection{data: c.data, err: fmt.Errorf("Repeat() result length overflows int: %d elements repeated %d times", v.Len(), times)}
+	}
+
+	resultSlice := reflect.MakeSlice(v.Type(), v.Len()*times, v.Len()*times)
+	for i := 0; i < times; i++ {
+		reflect.Copy(resultSlice.Slice(i*v.Len(), (i+1)*v.Len()), v)
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
@@ -0,0 +1,119 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRepeat(t *testing.T) {
+	t.Run("successful repeat", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			times    int
+			expected any
+		}{
+			{
+				name:     "zero times",
+				input:    []int{1, 2},
+				times:    0,
+				expected: []int{},
+			},
+			{
+				name:     "negative times",
+				input:    []int{1, 2},
+				times:    -1,
+				expected: []int{},
+			},
+			{
+				name:     "once",
+				input:    []int{1, 2},
+				times:    1,
+				expected: []int{1, 2},
+			},
+			{
+				name:     "three times",
+				input:    []int{1, 2},
+				times:    3,
+				expected: []int{1, 2, 1, 2, 1, 2},
+			},
+			{
+				name:     "empty slice",
+				input:    []string{},
+				times:    3,
+				expected: []string{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Repeat(tt.times).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("result does not share memory", func(t *testing.T) {
+		input := []int{1, 2}
+		result, err := ToTypedSlice[int](FromSlice(input).Repeat(1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		result[0] = 100
+
+		if input[0] != 1 {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			times    int
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				times:    2,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-slice data",
+				setup:    Collection{data: 42},
+				times:    2,
+				errorMsg: "underlying data is not a slice",
+			},
+			{
+				name:     "result length overflows",
+				setup:    FromSlice([]int{1, 2}),
+				times:    math.MaxInt,
+				errorMsg: "Repeat() result length overflows int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Repeat(tt.times).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
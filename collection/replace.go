@@ -0,0 +1,129 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Replace returns a new Collection in which every element equal to oldValue is
+// replaced with newValue. The underlying slice is not modified.
+//
+// The element type of the slice must be comparable, and both oldValue and
+// newValue must be assignable to it. A nil value is accepted for element types
+// that can be nil, such as pointers and interfaces.
+//
+// For interface element types such as any, oldValue must also hold a
+// comparable dynamic value. Elements holding non-comparable values, such as
+// slices or maps, never match.
+//
+// Example:
+//
+//	c := FromSlice([]string{"a", "", "b", ""}).Replace("", "n/a")
+//	// c.ToSlice() == []string{"a", "n/a", "b", "n/a"}
+func (c Collection) Replace(oldValue, newValue any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	elemType := v.Type().Elem()
+
+	// Check to make sure the elements can be compared with ==.
+	if !elemType.Comparable() {
+		return Collection{data: c.data, err: fmt.Errorf("Replace() requires a comparable element type. Got %s", elemType)}
+	}
+
+	// Check to make sure both values match the slice element type. Converting to the
+	// element type makes interface element types compare by dynamic value.
+	target, oldOk := elemValue(oldValue, elemType)
+	replacement, newOk := elemValue(newValue, elemType)
+	if !oldOk || !newOk {
+		return Collection{data: c.data, err: fmt.Errorf("Replace() values must be of type %s", elemType)}
+	}
+
+	// Check to make sure the dynamic value can be compared, which matters for interface element types.
+	if !target.Comparable() {
+		return Collection{data: c.data, err: fmt.Errorf("Replace() old value must be comparable. Got %T", oldValue)}
+	}
+
+	resultSlice := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(resultSlice, v)
+
+	for i := 0; i < resultSlice.Len(); i++ {
+		if resultSlice.Index(i).Equal(target) {
+			resultSlice.Index(i).Set(replacement)
+		}
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
+
+// ReplaceFunc returns a new Collection in which every element for which pred
+// returns true is replaced with the result of calling f on it. The underlying
+// slice is not modified.
+//
+// The provided functions must:
+//   - pred: take one argument matching the element type of the slice and return exactly one bool value
+//   - f: take one argument matching the element type of the slice and return exactly one value of that type
+//
+// Example:
+//
+//	c := FromSlice([]int{-2, 1, -3}).ReplaceFunc(
+//	    func(n int) bool { return n < 0 },
+//	    func(n int) int { return -n },
+//	)
+//	// c.ToSlice() == []int{2, 1, 3}
+func (c Collection) ReplaceFunc(pred, f any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	predVal := reflect.ValueOf(pred)
+	predType := predVal.Type()
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure pred is a function that takes one input and that it matches the slice element type.
+	if predType.Kind() != reflect.Func || predType.NumIn() != 1 || !predType.In(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("ReplaceFunc() predicate must take exactly one argument of type %s", elemType)}
+	}
+
+	// Check to make sure pred returns a bool.
+	if predType.NumOut() != 1 || predType.Out(0).Kind() != reflect.Bool {
+		return Collection{data: c.data, err: errors.New("ReplaceFunc() predicate must return exactly one bool value")}
+	}
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("ReplaceFunc() function must take exactly one argument of type %s", elemType)}
+	}
+
+	// Check to make sure f returns a value of the slice element type.
+	if fType.NumOut() != 1 || !fType.Out(0).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("ReplaceFunc() function must return exactly one value of type %s", elemType)}
+	}
+
+	resultSlice := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		if predVal.Call([]reflect.Value{elem})[0].Bool() {
+			elem = fVal.Call([]reflect.Value{elem})[0]
+		}
+
+		resultSlice.Index(i).Set(elem)
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
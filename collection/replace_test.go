@@ -0,0 +1,276 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReplace(t *testing.T) {
+	t.Run("successful replace", func(t *testing.T) {
+		one := 1
+
+		tests := []struct {
+			name     string
+			input    any
+			oldValue any
+			newValue any
+			expected any
+		}{
+			{
+				name:     "replace specific int",
+				input:    []int{1, 2, 1, 3},
+				oldValue: 1,
+				newValue: 9,
+				expected: []int{9, 2, 9, 3},
+			},
+			{
+				name:     "replace empty strings",
+				input:    []string{"a", "", "b", ""},
+				oldValue: "",
+				newValue: "n/a",
+				expected: []string{"a", "n/a", "b", "n/a"},
+			},
+			{
+				name:     "no matches",
+				input:    []int{1, 2, 3},
+				oldValue: 4,
+				newValue: 5,
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "interface element type",
+				input:    []any{1, "1", 1.0},
+				oldValue: 1,
+				newValue: "one",
+				expected: []any{"one", "1", 1.0},
+			},
+			{
+				name:     "interface elements holding non-comparable values",
+				input:    []any{[]int{1}, 1},
+				oldValue: 1,
+				newValue: "one",
+				expected: []any{[]int{1}, "one"},
+			},
+			{
+				name:     "replace nil interface elements",
+				input:    []any{1, nil, "a"},
+				oldValue: nil,
+				newValue: 0,
+				expected: []any{1, 0, "a"},
+			},
+			{
+				name:     "replace with nil pointer",
+				input:    []*int{nil, &one, nil},
+				oldValue: &one,
+				newValue: nil,
+				expected: []*int{nil, nil, nil},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				oldValue: 1,
+				newValue: 2,
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Replace(tt.oldValue, tt.newValue).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("original slice is not modified", func(t *testing.T) {
+		input := []int{1, 2, 1}
+		_, _ = FromSlice(input).Replace(1, 0).ToSlice()
+
+		if !reflect.DeepEqual(input, []int{1, 2, 1}) {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			oldValue any
+			newValue any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				oldValue: 1,
+				newValue: 2,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-comparable element type",
+				setup:    FromSlice([][]int{{1}, {2}}),
+				oldValue: []int{1},
+				newValue: []int{3},
+				errorMsg: "Replace() requires a comparable element type. Got []int",
+			},
+			{
+				name:     "old value of wrong type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				oldValue: "1",
+				newValue: 2,
+				errorMsg: "Replace() values must be of type int",
+			},
+			{
+				name:     "new value of wrong type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				oldValue: 1,
+				newValue: "2",
+				errorMsg: "Replace() values must be of type int",
+			},
+			{
+				name:     "nil value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				oldValue: nil,
+				newValue: 2,
+				errorMsg: "Replace() values must be of type int",
+			},
+			{
+				name:     "non-comparable old value for interface element type",
+				setup:    FromSlice([]any{1, []int{2}}),
+				oldValue: []int{2},
+				newValue: 3,
+				errorMsg: "Replace() old value must be comparable. Got []int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := tt.setup.Replace(tt.oldValue, tt.newValue)
+
+				if result.err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(result.err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, result.err.Error())
+				}
+			})
+		}
+	})
+}
+
+func TestReplaceFunc(t *testing.T) {
+	t.Run("successful replace", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			pred     any
+			f        any
+			expected any
+		}{
+			{
+				name:     "absolute value of negatives",
+				input:    []int{-2, 1, -3, 0},
+				pred:     func(n int) bool { return n < 0 },
+				f:        func(n int) int { return -n },
+				expected: []int{2, 1, 3, 0},
+			},
+			{
+				name:     "trim padded strings",
+				input:    []string{" a ", "b", "  c"},
+				pred:     func(s string) bool { return strings.HasPrefix(s, " ") },
+				f:        strings.TrimSpace,
+				expected: []string{"a", "b", "c"},
+			},
+			{
+				name:     "non-comparable element type",
+				input:    [][]int{{1}, {}, {2, 3}},
+				pred:     func(s []int) bool { return len(s) == 0 },
+				f:        func(s []int) []int { return []int{0} },
+				expected: [][]int{{1}, {0}, {2, 3}},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).ReplaceFunc(tt.pred, tt.f).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		isNegative := func(n int) bool { return n < 0 }
+		negate := func(n int) int { return -n }
+
+		tests := []struct {
+			name     string
+			setup    Collection
+			pred     any
+			f        any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				pred:     isNegative,
+				f:        negate,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "predicate with wrong argument type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				pred:     func(s string) bool { return s == "" },
+				f:        negate,
+				errorMsg: "ReplaceFunc() predicate must take exactly one argument of type int",
+			},
+			{
+				name:     "predicate returns non-bool",
+				setup:    FromSlice([]int{1, 2, 3}),
+				pred:     negate,
+				f:        negate,
+				errorMsg: "ReplaceFunc() predicate must return exactly one bool value",
+			},
+			{
+				name:     "function not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				pred:     isNegative,
+				f:        "not a function",
+				errorMsg: "ReplaceFunc() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function returns wrong type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				pred:     isNegative,
+				f:        func(n int) string { return "" },
+				errorMsg: "ReplaceFunc() function must return exactly one value of type int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := tt.setup.ReplaceFunc(tt.pred, tt.f)
+
+				if result.err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(result.err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, result.err.Error())
+				}
+			})
+		}
+	})
+}
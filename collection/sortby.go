@@ -0,0 +1,84 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SortBy returns a new Collection with the elements of the underlying slice
+// sorted according to the provided less function. The underlying slice is not
+// modified. The sort is not guaranteed to be stable; use SortStableBy when equal
+// elements must keep their original order.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take two arguments, both matching the element type of the slice
+//   - Return exactly one bool value, reporting whether the first argument should sort before the second
+//
+// Example:
+//
+//	c := FromSlice(people).SortBy(func(a, b Person) bool { return a.Age < b.Age })
+func (c Collection) SortBy(less any) Collection {
+	return c.sortBy("SortBy", less, sort.Slice)
+}
+
+// SortStableBy returns a new Collection with the elements of the underlying
+// slice sorted according to the provided less function, keeping elements that
+// compare equal in their original order. The underlying slice is not modified.
+//
+// This makes it possible to sort by several keys by sorting on the secondary key
+// first and the primary key last.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take two arguments, both matching the element type of the slice
+//   - Return exactly one bool value, reporting whether the first argument should sort before the second
+//
+// Example:
+//
+//	c := FromSlice(people).
+//	    SortStableBy(func(a, b Person) bool { return a.Name < b.Name }).
+//	    SortStableBy(func(a, b Person) bool { return a.Age < b.Age })
+func (c Collection) SortStableBy(less any) Collection {
+	return c.sortBy("SortStableBy", less, sort.SliceStable)
+}
+
+// sortBy implements SortBy and SortStableBy using the given sort function. The
+// name is used in error messages.
+func (c Collection) sortBy(name string, less any, sortFn func(any, func(i, j int) bool)) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	fVal := reflect.ValueOf(less)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	if fType.Kind() != reflect.Func ||
+		fType.NumIn() != 2 ||
+		!fType.In(0).AssignableTo(elemType) ||
+		!fType.In(1).AssignableTo(elemType) {
+		return Collection{data: c.data, err: fmt.Errorf("%s() function must take exactly two arguments of type %s", name, elemType)}
+	}
+
+	if fType.NumOut() != 1 || fType.Out(0).Kind() != reflect.Bool {
+		return Collection{data: c.data, err: fmt.Errorf("%s() function must return exactly one bool value", name)}
+	}
+
+	// Sort a copy so the caller's slice is left untouched.
+	resultSlice := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(resultSlice, v)
+
+	sortFn(resultSlice.Interface(), func(i, j int) bool {
+		return fVal.Call([]reflect.Value{resultSlice.Index(i), resultSlice.Index(j)})[0].Bool()
+	})
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
@@ -0,0 +1,169 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type sortByPerson struct {
+	Name string
+	Age  int
+}
+
+var sortByPeople = []sortByPerson{
+	{Name: "Carol", Age: 35},
+	{Name: "Alice", Age: 30},
+	{Name: "Dave", Age: 30},
+	{Name: "Bob", Age: 25},
+	{Name: "Eve", Age: 30},
+}
+
+func TestSortBy(t *testing.T) {
+	t.Run("successful sort", func(t *testing.T) {
+		result, err := FromSlice([]int{3, 1, 2}).SortBy(func(a, b int) bool { return a < b }).ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("expected %v, got %v", []int{1, 2, 3}, result)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		result := FromSlice([]int{1}).SortBy(func(a, b string) bool { return a < b })
+
+		if result.err == nil || !strings.Contains(result.err.Error(), "SortBy() function must take exactly two arguments of type int") {
+			t.Errorf("unexpected error: %v", result.err)
+		}
+	})
+}
+
+func TestSortStableBy(t *testing.T) {
+	t.Run("successful sort", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			lessFunc any
+			expected any
+		}{
+			{
+				name:     "ties keep their original order",
+				input:    sortByPeople,
+				lessFunc: func(a, b sortByPerson) bool { return a.Age < b.Age },
+				expected: []sortByPerson{
+					{Name: "Bob", Age: 25},
+					{Name: "Alice", Age: 30},
+					{Name: "Dave", Age: 30},
+					{Name: "Eve", Age: 30},
+					{Name: "Carol", Age: 35},
+				},
+			},
+			{
+				name:     "descending",
+				input:    []int{1, 3, 2},
+				lessFunc: func(a, b int) bool { return a > b },
+				expected: []int{3, 2, 1},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				lessFunc: func(a, b int) bool { return a < b },
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).SortStableBy(tt.lessFunc).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("sort by secondary then primary key", func(t *testing.T) {
+		result, err := FromSlice(sortByPeople).
+			SortStableBy(func(a, b sortByPerson) bool { return a.Name > b.Name }).
+			SortStableBy(func(a, b sortByPerson) bool { return a.Age < b.Age }).
+			ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []sortByPerson{
+			{Name: "Bob", Age: 25},
+			{Name: "Eve", Age: 30},
+			{Name: "Dave", Age: 30},
+			{Name: "Alice", Age: 30},
+			{Name: "Carol", Age: 35},
+		}
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("original slice is not modified", func(t *testing.T) {
+		input := []int{3, 1, 2}
+		_, _ = FromSlice(input).SortStableBy(func(a, b int) bool { return a < b }).ToSlice()
+
+		if !reflect.DeepEqual(input, []int{3, 1, 2}) {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			lessFunc any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				lessFunc: func(a, b int) bool { return a < b },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: "not a function",
+				errorMsg: "SortStableBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function with one argument",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a int) bool { return a > 0 },
+				errorMsg: "SortStableBy() function must take exactly two arguments of type int",
+			},
+			{
+				name:     "function returns non-bool",
+				setup:    FromSlice([]int{1, 2, 3}),
+				lessFunc: func(a, b int) int { return a - b },
+				errorMsg: "SortStableBy() function must return exactly one bool value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := tt.setup.SortStableBy(tt.lessFunc)
+
+				if result.err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(result.err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, result.err.Error())
+				}
+			})
+		}
+	})
+}
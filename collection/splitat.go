@@ -0,0 +1,39 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+)
+
+// SplitAt divides the underlying slice at index n, returning the elements in
+// [0, n) and the elements in [n, len) as two new slices of the element type
+// ([]T).
+//
+// n is clamped to the valid range, so a negative n yields an empty first half
+// and an n greater than or equal to the length yields an empty second half.
+// Both halves are copies and never share memory with the original slice.
+//
+// Example:
+//
+//	left, right, err := FromSlice([]int{1, 2, 3, 4}).SplitAt(1)
+//	// left == []int{1}, right == []int{2, 3, 4}
+func (c Collection) SplitAt(n int) (any, any, error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, nil, errors.New("underlying data is not a slice")
+	}
+
+	n = max(0, min(n, v.Len()))
+
+	left := reflect.MakeSlice(v.Type(), n, n)
+	reflect.Copy(left, v.Slice(0, n))
+
+	right := reflect.MakeSlice(v.Type(), v.Len()-n, v.Len()-n)
+	reflect.Copy(right, v.Slice(n, v.Len()))
+
+	return left.Interface(), right.Interface(), nil
+}
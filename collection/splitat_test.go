@@ -0,0 +1,127 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitAt(t *testing.T) {
+	t.Run("successful split", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			input         any
+			n             int
+			expectedLeft  any
+			expectedRight any
+		}{
+			{
+				name:          "index in range",
+				input:         []int{1, 2, 3, 4},
+				n:             1,
+				expectedLeft:  []int{1},
+				expectedRight: []int{2, 3, 4},
+			},
+			{
+				name:          "zero index",
+				input:         []int{1, 2, 3},
+				n:             0,
+				expectedLeft:  []int{},
+				expectedRight: []int{1, 2, 3},
+			},
+			{
+				name:          "index equal to length",
+				input:         []string{"a", "b"},
+				n:             2,
+				expectedLeft:  []string{"a", "b"},
+				expectedRight: []string{},
+			},
+			{
+				name:          "index beyond length",
+				input:         []string{"a", "b"},
+				n:             10,
+				expectedLeft:  []string{"a", "b"},
+				expectedRight: []string{},
+			},
+			{
+				name:          "negative index",
+				input:         []int{1, 2, 3},
+				n:             -2,
+				expectedLeft:  []int{},
+				expectedRight: []int{1, 2, 3},
+			},
+			{
+				name:          "empty slice",
+				input:         []int{},
+				n:             1,
+				expectedLeft:  []int{},
+				expectedRight: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				left, right, err := FromSlice(tt.input).SplitAt(tt.n)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(left, tt.expectedLeft) {
+					t.Errorf("expected left %#v, got %#v", tt.expectedLeft, left)
+				}
+
+				if !reflect.DeepEqual(right, tt.expectedRight) {
+					t.Errorf("expected right %#v, got %#v", tt.expectedRight, right)
+				}
+			})
+		}
+	})
+
+	t.Run("halves are copies", func(t *testing.T) {
+		input := []int{1, 2, 3}
+		left, right, err := FromSlice(input).SplitAt(1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		left.([]int)[0] = 100
+		right.([]int)[0] = 200
+
+		if !reflect.DeepEqual(input, []int{1, 2, 3}) {
+			t.Errorf("expected input to be unchanged, got %v", input)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-slice data",
+				setup:    Collection{data: 42},
+				errorMsg: "underlying data is not a slice",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := tt.setup.SplitAt(1)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Average returns the arithmetic mean of the elements in the underlying slice.
+//
+// The element type must be a numeric kind (any signed or unsigned integer or
+// float type). The values are converted to float64 for the computation. An
+// error is returned for non-numeric element types and for empty slices.
+//
+// Example:
+//
+//	mean, err := FromSlice([]int{1, 2, 3, 4}).Average()
+//	// mean == 2.5
+func (c Collection) Average() (float64, error) {
+	values, err := c.floatValues("Average")
+	if err != nil {
+		return 0, err
+	}
+
+	return mean(values), nil
+}
+
+// StdDev returns the population standard deviation of the elements in the
+// underlying slice.
+//
+// The element type must be a numeric kind (any signed or unsigned integer or
+// float type). The values are converted to float64 for the computation. An
+// error is returned for non-numeric element types and for empty slices.
+//
+// Example:
+//
+//	sd, err := FromSlice([]int{2, 4, 4, 4, 5, 5, 7, 9}).StdDev()
+//	// sd == 2
+func (c Collection) StdDev() (float64, error) {
+	values, err := c.floatValues("StdDev")
+	if err != nil {
+		return 0, err
+	}
+
+	m := mean(values)
+
+	var sumSquares float64
+	for _, value := range values {
+		sumSquares += (value - m) * (value - m)
+	}
+
+	return math.Sqrt(sumSquares / float64(len(values))), nil
+}
+
+// floatValues converts the numeric elements of the underlying slice to float64.
+// The name is used in error messages.
+func (c Collection) floatValues(name string) ([]float64, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.New("underlying data is not a slice")
+	}
+
+	elemType := v.Type().Elem()
+
+	var convert func(reflect.Value) float64
+
+	switch elemType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		convert = func(e reflect.Value) float64 { return float64(e.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		convert = func(e reflect.Value) float64 { return float64(e.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		convert = func(e reflect.Value) float64 { return e.Float() }
+	default:
+		return nil, fmt.Errorf("%s() requires a numeric element type. Got %s", name, elemType)
+	}
+
+	if v.Len() == 0 {
+		return nil, fmt.Errorf("%s() cannot be called on an empty collection", name)
+	}
+
+	values := make([]float64, v.Len())
+	for i := range values {
+		values[i] = convert(v.Index(i))
+	}
+
+	return values, nil
+}
+
+// mean returns the arithmetic mean of a non-empty slice of values.
+func mean(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
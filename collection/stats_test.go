@@ -0,0 +1,172 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+const statsEpsilon = 1e-9
+
+func TestAverage(t *testing.T) {
+	t.Run("successful average", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			expected float64
+		}{
+			{
+				name:     "int slice",
+				setup:    FromSlice([]int{2, 4, 4, 4, 5, 5, 7, 9}),
+				expected: 5,
+			},
+			{
+				name:     "uint8 slice",
+				setup:    FromSlice([]uint8{1, 2}),
+				expected: 1.5,
+			},
+			{
+				name:     "float slice",
+				setup:    FromSlice([]float64{0.5, 1.5, 2.5}),
+				expected: 1.5,
+			},
+			{
+				name:     "after map",
+				setup:    FromSlice([]string{"a", "bb", "ccc"}).Map(func(s string) int { return len(s) }),
+				expected: 2,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.setup.Average()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if math.Abs(result-tt.expected) > statsEpsilon {
+					t.Errorf("expected %f, got %f", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-numeric element type",
+				setup:    FromSlice([]string{"a", "b"}),
+				errorMsg: "Average() requires a numeric element type. Got string",
+			},
+			{
+				name:     "empty slice",
+				setup:    FromSlice([]int{}),
+				errorMsg: "Average() cannot be called on an empty collection",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Average()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
+
+func TestStdDev(t *testing.T) {
+	t.Run("successful standard deviation", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			expected float64
+		}{
+			{
+				name:     "known int slice",
+				setup:    FromSlice([]int{2, 4, 4, 4, 5, 5, 7, 9}),
+				expected: 2,
+			},
+			{
+				name:     "identical values",
+				setup:    FromSlice([]int{3, 3, 3}),
+				expected: 0,
+			},
+			{
+				name:     "single value",
+				setup:    FromSlice([]float32{42}),
+				expected: 0,
+			},
+			{
+				name:     "float slice",
+				setup:    FromSlice([]float64{1, 2, 3, 4}),
+				expected: math.Sqrt(1.25),
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.setup.StdDev()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if math.Abs(result-tt.expected) > statsEpsilon {
+					t.Errorf("expected %f, got %f", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-numeric element type",
+				setup:    FromSlice([]bool{true}),
+				errorMsg: "StdDev() requires a numeric element type. Got bool",
+			},
+			{
+				name:     "empty slice",
+				setup:    FromSlice([]float64{}),
+				errorMsg: "StdDev() cannot be called on an empty collection",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.StdDev()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Stride returns a new Collection holding every n-th element of the underlying
+// slice, starting from index 0. A stride of 2 keeps indices 0, 2, 4 and so on,
+// while a stride of 1 keeps every element. The order of the kept elements is
+// preserved.
+//
+// It returns an error if n is less than or equal to zero.
+//
+// Example:
+//
+//	c := FromSlice([]int{1, 2, 3, 4, 5}).Stride(2)
+//	// c.ToSlice() == []int{1, 3, 5}
+func (c Collection) Stride(n int) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	// Check to make sure the stride moves forward.
+	if n <= 0 {
+		return Collection{data: c.data, err: fmt.Errorf("Stride() step must be greater than 0. Got %d", n)}
+	}
+
+	// Count the kept elements without computing v.Len()+n, which overflows for large n.
+	length := 0
+	if v.Len() > 0 {
+		length = (v.Len()-1)/n + 1
+	}
+
+	resultSlice := reflect.MakeSlice(v.Type(), length, length)
+	for i := 0; i < length; i++ {
+		resultSlice.Index(i).Set(v.Index(i * n))
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
@@ -0,0 +1,117 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStride(t *testing.T) {
+	t.Run("successful stride", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			n        int
+			expected any
+		}{
+			{
+				name:     "stride of one",
+				input:    []int{1, 2, 3, 4},
+				n:        1,
+				expected: []int{1, 2, 3, 4},
+			},
+			{
+				name:     "stride of two",
+				input:    []int{1, 2, 3, 4, 5},
+				n:        2,
+				expected: []int{1, 3, 5},
+			},
+			{
+				name:     "stride of three",
+				input:    []string{"a", "b", "c", "d", "e", "f", "g"},
+				n:        3,
+				expected: []string{"a", "d", "g"},
+			},
+			{
+				name:     "input shorter than stride",
+				input:    []int{7, 8},
+				n:        5,
+				expected: []int{7},
+			},
+			{
+				name:     "very large stride",
+				input:    []int{1, 2, 3},
+				n:        math.MaxInt,
+				expected: []int{1},
+			},
+			{
+				name:     "empty slice",
+				input:    []int{},
+				n:        2,
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Stride(tt.n).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			n        int
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				n:        2,
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-slice data",
+				setup:    Collection{data: 42},
+				n:        2,
+				errorMsg: "underlying data is not a slice",
+			},
+			{
+				name:     "zero stride",
+				setup:    FromSlice([]int{1, 2, 3}),
+				n:        0,
+				errorMsg: "Stride() step must be greater than 0. Got 0",
+			},
+			{
+				name:     "negative stride",
+				setup:    FromSlice([]int{1, 2, 3}),
+				n:        -2,
+				errorMsg: "Stride() step must be greater than 0. Got -2",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.setup.Stride(tt.n).ToSlice()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package collection
+
+import "github.com/PsionicAlch/byteforge/datastructs/set"
+
+// ToSet materializes the result of the Collection into a typed Set, dropping
+// duplicate elements.
+//
+// It is a standalone generic function (not a method) due to Go's generic limitations.
+// The type parameter T specifies the element type.
+//
+// Example:
+//
+//	tags, err := ToSet[string](FromSlice(posts).Map(func(p Post) string { return p.Tag }))
+//
+// This function will return an error if the underlying data cannot be cast to []T
+// or if the provided Collection already contains an error.
+func ToSet[T comparable](c Collection) (*set.Set[T], error) {
+	slice, err := ToTypedSlice[T](c)
+	if err != nil {
+		return nil, err
+	}
+
+	return set.FromSlice(slice), nil
+}
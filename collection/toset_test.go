@@ -0,0 +1,86 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/PsionicAlch/byteforge/datastructs/set"
+)
+
+func TestToSet(t *testing.T) {
+	t.Run("successful conversion", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			expected *set.Set[string]
+		}{
+			{
+				name: "mapped collection with duplicates",
+				setup: FromSlice([]int{1, 2, 11, 3, 22}).Map(func(n int) string {
+					return strings.Repeat("x", n%10)
+				}),
+				expected: set.FromSlice([]string{"x", "xx", "xxx"}),
+			},
+			{
+				name:     "no duplicates",
+				setup:    FromSlice([]string{"a", "b"}),
+				expected: set.FromSlice([]string{"a", "b"}),
+			},
+			{
+				name:     "empty collection",
+				setup:    FromSlice([]string{}),
+				expected: set.New[string](),
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := ToSet[string](tt.setup)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !result.Equals(tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected.ToSlice(), result.ToSlice())
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "wrong element type",
+				setup:    FromSlice([]int{1, 2}),
+				errorMsg: "cannot cast slice to type []string",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := ToSet[string](tt.setup)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+
+				if result != nil {
+					t.Errorf("expected nil set, got %v", result.ToSlice())
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// errorType is the reflected type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// TryForEach applies the provided function to each element of the underlying
+// slice in order, stopping at the first element for which the function returns
+// a non-nil error.
+//
+// The provided function must:
+//   - Be a function type
+//   - Take one argument matching the element type of the slice
+//   - Return exactly one error value
+//
+// The returned error wraps the function's error and includes the index of the
+// element that caused it, so errors.Is and errors.As work on the result. Any
+// error already carried by the Collection is returned without calling the function.
+//
+// Example:
+//
+//	err := FromSlice(users).TryForEach(func(u User) error {
+//	    return db.Save(u)
+//	})
+func (c Collection) TryForEach(f any) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return errors.New("underlying data is not a slice")
+	}
+
+	fVal := reflect.ValueOf(f)
+	fType := fVal.Type()
+	elemType := v.Type().Elem()
+
+	// Check to make sure f is a function that takes one input and that it matches the slice element type.
+	if fType.Kind() != reflect.Func || fType.NumIn() != 1 || !fType.In(0).AssignableTo(elemType) {
+		return fmt.Errorf("TryForEach() function must take exactly one argument of type %s", elemType)
+	}
+
+	// Check to make sure that f only returns an error.
+	if fType.NumOut() != 1 || fType.Out(0) != errorType {
+		return errors.New("TryForEach() function must return exactly one error value")
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		out := fVal.Call([]reflect.Value{v.Index(i)})
+		if !out[0].IsNil() {
+			return fmt.Errorf("TryForEach() failed at index %d: %w", i, out[0].Interface().(error))
+		}
+	}
+
+	return nil
+}
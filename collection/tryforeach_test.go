@@ -0,0 +1,129 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTryForEach(t *testing.T) {
+	t.Run("function never errors", func(t *testing.T) {
+		var visited []int
+
+		err := FromSlice([]int{1, 2, 3}).TryForEach(func(n int) error {
+			visited = append(visited, n)
+			return nil
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if len(visited) != 3 || visited[0] != 1 || visited[1] != 2 || visited[2] != 3 {
+			t.Errorf("expected to visit [1 2 3], visited %v", visited)
+		}
+	})
+
+	t.Run("function errors on specific element", func(t *testing.T) {
+		errBad := errors.New("bad element")
+		var visited []int
+
+		err := FromSlice([]int{1, 2, 3, 4}).TryForEach(func(n int) error {
+			visited = append(visited, n)
+			if n == 3 {
+				return errBad
+			}
+			return nil
+		})
+
+		if err == nil {
+			t.Fatal("expected error but got none")
+		}
+
+		if !errors.Is(err, errBad) {
+			t.Errorf("expected error to wrap %v, got %v", errBad, err)
+		}
+
+		if !strings.Contains(err.Error(), "index 2") {
+			t.Errorf("expected error to mention index 2, got %q", err.Error())
+		}
+
+		if len(visited) != 3 {
+			t.Errorf("expected processing to stop after 3 elements, visited %v", visited)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		called := false
+		err := FromSlice([]string{}).TryForEach(func(s string) error {
+			called = true
+			return nil
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if called {
+			t.Error("function should not be called for empty slice")
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			function any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				function: func(n int) error { return nil },
+				errorMsg: "existing error",
+			},
+			{
+				name:     "not a function",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: "not a function",
+				errorMsg: "TryForEach() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function with wrong input type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: func(s string) error { return nil },
+				errorMsg: "TryForEach() function must take exactly one argument of type int",
+			},
+			{
+				name:     "function returns nothing",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: func(n int) {},
+				errorMsg: "TryForEach() function must return exactly one error value",
+			},
+			{
+				name:     "function returns non-error",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: func(n int) string { return "" },
+				errorMsg: "TryForEach() function must return exactly one error value",
+			},
+			{
+				name:     "function returns multiple values",
+				setup:    FromSlice([]int{1, 2, 3}),
+				function: func(n int) (int, error) { return n, nil },
+				errorMsg: "TryForEach() function must return exactly one error value",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.setup.TryForEach(tt.function)
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
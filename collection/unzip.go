@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Unzip splits a slice of two-field structs, such as tuple.Pair, into two
+// slices: one holding the first field of every element and one holding the
+// second. It reverses zipping two slices into Pairs.
+//
+// The element type must be a struct with exactly two exported fields. For a
+// []tuple.Pair[A, B] the returned values are a []A and a []B of the same length.
+//
+// Example:
+//
+//	firsts, seconds, err := FromSlice([]tuple.Pair[int, string]{
+//	    tuple.NewPair(1, "a"),
+//	    tuple.NewPair(2, "b"),
+//	}).Unzip()
+//	// firsts == []int{1, 2}, seconds == []string{"a", "b"}
+func (c Collection) Unzip() (any, any, error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return nil, nil, errors.New("underlying data is not a slice")
+	}
+
+	elemType := v.Type().Elem()
+
+	// Check to make sure the elements are structs with exactly two exported fields.
+	if elemType.Kind() != reflect.Struct || elemType.NumField() != 2 ||
+		!elemType.Field(0).IsExported() || !elemType.Field(1).IsExported() {
+		return nil, nil, fmt.Errorf("Unzip() requires an element type with exactly two exported fields, such as tuple.Pair. Got %s", elemType)
+	}
+
+	firsts := reflect.MakeSlice(reflect.SliceOf(elemType.Field(0).Type), v.Len(), v.Len())
+	seconds := reflect.MakeSlice(reflect.SliceOf(elemType.Field(1).Type), v.Len(), v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		firsts.Index(i).Set(v.Index(i).Field(0))
+		seconds.Index(i).Set(v.Index(i).Field(1))
+	}
+
+	return firsts.Interface(), seconds.Interface(), nil
+}
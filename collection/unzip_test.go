@@ -0,0 +1,124 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PsionicAlch/byteforge/datastructs/tuple"
+	"github.com/PsionicAlch/byteforge/functions/slices"
+)
+
+func TestUnzip(t *testing.T) {
+	t.Run("successful unzip", func(t *testing.T) {
+		type entry struct {
+			Key   string
+			Value float64
+		}
+
+		tests := []struct {
+			name            string
+			input           any
+			expectedFirsts  any
+			expectedSeconds any
+		}{
+			{
+				name:            "pairs",
+				input:           []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")},
+				expectedFirsts:  []int{1, 2},
+				expectedSeconds: []string{"a", "b"},
+			},
+			{
+				name:            "two-field struct",
+				input:           []entry{{"x", 1.5}, {"y", 2.5}},
+				expectedFirsts:  []string{"x", "y"},
+				expectedSeconds: []float64{1.5, 2.5},
+			},
+			{
+				name:            "empty slice",
+				input:           []tuple.Pair[int, string]{},
+				expectedFirsts:  []int{},
+				expectedSeconds: []string{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				firsts, seconds, err := FromSlice(tt.input).Unzip()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(firsts, tt.expectedFirsts) {
+					t.Errorf("expected %v, got %v", tt.expectedFirsts, firsts)
+				}
+
+				if !reflect.DeepEqual(seconds, tt.expectedSeconds) {
+					t.Errorf("expected %v, got %v", tt.expectedSeconds, seconds)
+				}
+			})
+		}
+	})
+
+	t.Run("round trip with slices.Zip", func(t *testing.T) {
+		nums := []int{1, 2, 3}
+		letters := []string{"a", "b", "c"}
+
+		firsts, seconds, err := FromSlice(slices.Zip(nums, letters)).Unzip()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(firsts, nums) || !reflect.DeepEqual(seconds, letters) {
+			t.Errorf("expected %v and %v, got %v and %v", nums, letters, firsts, seconds)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		type unexported struct {
+			a int
+			b int
+		}
+
+		tests := []struct {
+			name     string
+			setup    Collection
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-struct element type",
+				setup:    FromSlice([]int{1, 2}),
+				errorMsg: "Unzip() requires an element type with exactly two exported fields, such as tuple.Pair. Got int",
+			},
+			{
+				name:     "struct with three fields",
+				setup:    FromSlice([]struct{ A, B, C int }{{1, 2, 3}}),
+				errorMsg: "Unzip() requires an element type with exactly two exported fields",
+			},
+			{
+				name:     "struct with unexported fields",
+				setup:    FromSlice([]unexported{{1, 2}}),
+				errorMsg: "Unzip() requires an element type with exactly two exported fields",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := tt.setup.Unzip()
+
+				if err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, err.Error())
+				}
+			})
+		}
+	})
+}
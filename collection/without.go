@@ -0,0 +1,73 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Without returns a new Collection with every element equal to any of the
+// given values removed. The order of the remaining elements is preserved and
+// the underlying slice is not modified.
+//
+// The element type of the slice must be comparable, and every value must be
+// assignable to it. A nil value is accepted for element types that can be nil,
+// such as pointers and interfaces.
+//
+// For interface element types such as any, every value must also hold a
+// comparable dynamic value. Elements holding non-comparable values, such as
+// slices or maps, are never removed.
+//
+// Example:
+//
+//	c := FromSlice([]string{"a", "b", "c", "b"}).Without("b", "c")
+//	// c.ToSlice() == []string{"a"}
+func (c Collection) Without(values ...any) Collection {
+	if c.err != nil {
+		return c
+	}
+
+	v := reflect.ValueOf(c.data)
+	if v.Kind() != reflect.Slice {
+		return Collection{data: nil, err: errors.New("underlying data is not a slice")}
+	}
+
+	elemType := v.Type().Elem()
+
+	// Check to make sure the elements can be compared with ==.
+	if !elemType.Comparable() {
+		return Collection{data: c.data, err: fmt.Errorf("Without() requires a comparable element type. Got %s", elemType)}
+	}
+
+	excluded := make(map[any]struct{}, len(values))
+	for i, value := range values {
+		// Check to make sure each value matches the slice element type. Converting to the
+		// element type lets values of an assignable but different type still match.
+		target, ok := elemValue(value, elemType)
+		if !ok {
+			return Collection{data: c.data, err: fmt.Errorf("Without() value at index %d must be of type %s", i, elemType)}
+		}
+
+		// Check to make sure the dynamic value can be hashed, which matters for interface element types.
+		if !target.Comparable() {
+			return Collection{data: c.data, err: fmt.Errorf("Without() value at index %d must be comparable. Got %T", i, value)}
+		}
+
+		excluded[target.Interface()] = struct{}{}
+	}
+
+	resultSlice := reflect.MakeSlice(v.Type(), 0, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		// A non-comparable element cannot equal any of the values and cannot be used as a map key.
+		if v.Index(i).Comparable() {
+			if _, found := excluded[v.Index(i).Interface()]; found {
+				continue
+			}
+		}
+
+		resultSlice = reflect.Append(resultSlice, v.Index(i))
+	}
+
+	return Collection{data: resultSlice.Interface(), err: nil}
+}
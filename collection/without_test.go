@@ -0,0 +1,149 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWithout(t *testing.T) {
+	t.Run("successful removal", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    any
+			values   []any
+			expected any
+		}{
+			{
+				name:     "remove multiple values",
+				input:    []string{"a", "b", "c", "b", "d"},
+				values:   []any{"b", "c"},
+				expected: []string{"a", "d"},
+			},
+			{
+				name:     "remove single value",
+				input:    []int{1, 2, 1, 3},
+				values:   []any{1},
+				expected: []int{2, 3},
+			},
+			{
+				name:     "no values",
+				input:    []int{1, 2, 3},
+				values:   nil,
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "value not present",
+				input:    []int{1, 2, 3},
+				values:   []any{4},
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "interface elements holding non-comparable values",
+				input:    []any{[]int{1}, 1, "a"},
+				values:   []any{1},
+				expected: []any{[]int{1}, "a"},
+			},
+			{
+				name:     "nil value with interface elements",
+				input:    []any{1, nil, "a", nil},
+				values:   []any{nil},
+				expected: []any{1, "a"},
+			},
+			{
+				name:     "nil value with pointer elements",
+				input:    []*int{nil, nil},
+				values:   []any{nil},
+				expected: []*int{},
+			},
+			{
+				name:     "remove everything",
+				input:    []int{1, 1, 2},
+				values:   []any{1, 2},
+				expected: []int{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := FromSlice(tt.input).Without(tt.values...).ToSlice()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("expected %v, got %v", tt.expected, result)
+				}
+			})
+		}
+	})
+
+	t.Run("assignable values of a different type", func(t *testing.T) {
+		type point struct{ X, Y int }
+
+		input := []point{{1, 2}, {3, 4}}
+		result, err := FromSlice(input).Without(struct{ X, Y int }{1, 2}).ToSlice()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []point{{3, 4}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			setup    Collection
+			values   []any
+			errorMsg string
+		}{
+			{
+				name:     "collection with existing error",
+				setup:    Collection{data: nil, err: errors.New("existing error")},
+				values:   []any{1},
+				errorMsg: "existing error",
+			},
+			{
+				name:     "non-comparable element type",
+				setup:    FromSlice([][]int{{1}, {2}}),
+				values:   []any{[]int{1}},
+				errorMsg: "Without() requires a comparable element type. Got []int",
+			},
+			{
+				name:     "value of wrong type",
+				setup:    FromSlice([]int{1, 2, 3}),
+				values:   []any{1, "2"},
+				errorMsg: "Without() value at index 1 must be of type int",
+			},
+			{
+				name:     "non-comparable value for interface element type",
+				setup:    FromSlice([]any{1, []int{2}}),
+				values:   []any{1, []int{2}},
+				errorMsg: "Without() value at index 1 must be comparable. Got []int",
+			},
+			{
+				name:     "nil value",
+				setup:    FromSlice([]int{1, 2, 3}),
+				values:   []any{nil},
+				errorMsg: "Without() value at index 0 must be of type int",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := tt.setup.Without(tt.values...)
+
+				if result.err == nil {
+					t.Errorf("expected error but got none")
+				} else if !strings.Contains(result.err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMsg, result.err.Error())
+				}
+			})
+		}
+	})
+}
@@ -3,3 +3,7 @@ package constraints
 type Number interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
 }
+
+type Ordered interface {
+	Number | ~uintptr | ~string
+}
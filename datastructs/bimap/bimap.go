@@ -0,0 +1,118 @@
+// Package bimap provides a generic bidirectional map that supports constant
+// time lookups from key to value and from value to key.
+package bimap
+
+// Bimap is a generic one-to-one map. Every key maps to exactly one value and
+// every value maps back to exactly one key.
+//
+// K represents the type of the keys and V the type of the values.
+type Bimap[K comparable, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// New returns a new empty Bimap.
+func New[K comparable, V comparable]() *Bimap[K, V] {
+	return &Bimap[K, V]{
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+// FromMap creates a new Bimap from the given map. Because a Bimap is one-to-one,
+// if several keys share a value only one of them is kept and which one is
+// unspecified.
+func FromMap[K comparable, V comparable](m map[K]V) *Bimap[K, V] {
+	b := New[K, V]()
+	for k, v := range m {
+		b.Set(k, v)
+	}
+
+	return b
+}
+
+// FromSyncBimap creates a new Bimap from a given SyncBimap.
+// This results in a copy so the new map won't be connected to the original.
+func FromSyncBimap[K comparable, V comparable](src *SyncBimap[K, V]) *Bimap[K, V] {
+	src.mu.RLock()
+	defer src.mu.RUnlock()
+
+	return src.bimap.Clone()
+}
+
+// Set maps key to value in both directions.
+//
+// To keep the mapping one-to-one, any existing pair that conflicts with the new
+// one is evicted first: if key was mapped to another value, that value is
+// removed, and if value was mapped from another key, that key is removed. As a
+// result Set can shrink the Bimap by one pair when both conflicts occur.
+func (b *Bimap[K, V]) Set(key K, value V) {
+	if oldValue, ok := b.forward[key]; ok {
+		delete(b.inverse, oldValue)
+	}
+
+	if oldKey, ok := b.inverse[value]; ok {
+		delete(b.forward, oldKey)
+	}
+
+	b.forward[key] = value
+	b.inverse[value] = key
+}
+
+// GetByKey returns the value mapped to the given key and whether it was found.
+func (b *Bimap[K, V]) GetByKey(key K) (V, bool) {
+	value, ok := b.forward[key]
+	return value, ok
+}
+
+// GetByValue returns the key mapped to the given value and whether it was found.
+func (b *Bimap[K, V]) GetByValue(value V) (K, bool) {
+	key, ok := b.inverse[value]
+	return key, ok
+}
+
+// DeleteByKey removes the pair with the given key. It returns true if the pair was present.
+func (b *Bimap[K, V]) DeleteByKey(key K) bool {
+	value, ok := b.forward[key]
+	if !ok {
+		return false
+	}
+
+	delete(b.forward, key)
+	delete(b.inverse, value)
+
+	return true
+}
+
+// DeleteByValue removes the pair with the given value. It returns true if the pair was present.
+func (b *Bimap[K, V]) DeleteByValue(value V) bool {
+	key, ok := b.inverse[value]
+	if !ok {
+		return false
+	}
+
+	delete(b.forward, key)
+	delete(b.inverse, value)
+
+	return true
+}
+
+// Len returns the number of pairs in the Bimap.
+func (b *Bimap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// Clone creates a new Bimap with the same pairs.
+func (b *Bimap[K, V]) Clone() *Bimap[K, V] {
+	clone := &Bimap[K, V]{
+		forward: make(map[K]V, len(b.forward)),
+		inverse: make(map[V]K, len(b.inverse)),
+	}
+
+	for k, v := range b.forward {
+		clone.forward[k] = v
+		clone.inverse[v] = k
+	}
+
+	return clone
+}
@@ -0,0 +1,210 @@
+package bimap
+
+import "testing"
+
+// assertConsistent checks that the forward and inverse maps mirror each other.
+func assertConsistent[K comparable, V comparable](t *testing.T, b *Bimap[K, V]) {
+	t.Helper()
+
+	if len(b.forward) != len(b.inverse) {
+		t.Fatalf("Expected forward and inverse maps to have the same length. Got %d and %d", len(b.forward), len(b.inverse))
+	}
+
+	for k, v := range b.forward {
+		if got, ok := b.inverse[v]; !ok || got != k {
+			t.Errorf("Expected inverse[%v] to be %v. Got (%v, %v)", v, k, got, ok)
+		}
+	}
+}
+
+func TestBimap_New(t *testing.T) {
+	b := New[string, int]()
+
+	if b == nil || b.forward == nil || b.inverse == nil {
+		t.Fatal("Expected b and its internal maps to not be nil")
+	}
+
+	if b.Len() != 0 {
+		t.Errorf("Expected new Bimap to be empty. Got length %d", b.Len())
+	}
+}
+
+func TestBimap_FromMap(t *testing.T) {
+	b := FromMap(map[string]int{"a": 1, "b": 2})
+
+	if b.Len() != 2 {
+		t.Errorf("Expected length 2. Got %d", b.Len())
+	}
+
+	if k, ok := b.GetByValue(2); !ok || k != "b" {
+		t.Errorf("Expected GetByValue(2) to return (\"b\", true). Got (%q, %v)", k, ok)
+	}
+
+	assertConsistent(t, b)
+}
+
+func TestBimap_SetGet(t *testing.T) {
+	b := New[string, int]()
+	b.Set("one", 1)
+	b.Set("two", 2)
+
+	if v, ok := b.GetByKey("one"); !ok || v != 1 {
+		t.Errorf("Expected GetByKey(\"one\") to return (1, true). Got (%d, %v)", v, ok)
+	}
+
+	if k, ok := b.GetByValue(2); !ok || k != "two" {
+		t.Errorf("Expected GetByValue(2) to return (\"two\", true). Got (%q, %v)", k, ok)
+	}
+
+	if _, ok := b.GetByKey("three"); ok {
+		t.Error("Expected GetByKey(\"three\") to return false")
+	}
+
+	if _, ok := b.GetByValue(3); ok {
+		t.Error("Expected GetByValue(3) to return false")
+	}
+
+	assertConsistent(t, b)
+}
+
+func TestBimap_Overwrite(t *testing.T) {
+	t.Run("Existing key", func(t *testing.T) {
+		b := New[string, int]()
+		b.Set("a", 1)
+		b.Set("a", 2)
+
+		if b.Len() != 1 {
+			t.Errorf("Expected length 1. Got %d", b.Len())
+		}
+
+		if _, ok := b.GetByValue(1); ok {
+			t.Error("Expected old value 1 to be evicted")
+		}
+
+		if k, ok := b.GetByValue(2); !ok || k != "a" {
+			t.Errorf("Expected GetByValue(2) to return (\"a\", true). Got (%q, %v)", k, ok)
+		}
+
+		assertConsistent(t, b)
+	})
+
+	t.Run("Existing value", func(t *testing.T) {
+		b := New[string, int]()
+		b.Set("a", 1)
+		b.Set("b", 1)
+
+		if b.Len() != 1 {
+			t.Errorf("Expected length 1. Got %d", b.Len())
+		}
+
+		if _, ok := b.GetByKey("a"); ok {
+			t.Error("Expected old key \"a\" to be evicted")
+		}
+
+		if k, ok := b.GetByValue(1); !ok || k != "b" {
+			t.Errorf("Expected GetByValue(1) to return (\"b\", true). Got (%q, %v)", k, ok)
+		}
+
+		assertConsistent(t, b)
+	})
+
+	t.Run("Existing key and value", func(t *testing.T) {
+		b := New[string, int]()
+		b.Set("a", 1)
+		b.Set("b", 2)
+		b.Set("a", 2)
+
+		if b.Len() != 1 {
+			t.Errorf("Expected length 1. Got %d", b.Len())
+		}
+
+		if v, ok := b.GetByKey("a"); !ok || v != 2 {
+			t.Errorf("Expected GetByKey(\"a\") to return (2, true). Got (%d, %v)", v, ok)
+		}
+
+		if _, ok := b.GetByKey("b"); ok {
+			t.Error("Expected key \"b\" to be evicted")
+		}
+
+		if _, ok := b.GetByValue(1); ok {
+			t.Error("Expected value 1 to be evicted")
+		}
+
+		assertConsistent(t, b)
+	})
+
+	t.Run("Same pair", func(t *testing.T) {
+		b := New[string, int]()
+		b.Set("a", 1)
+		b.Set("a", 1)
+
+		if b.Len() != 1 {
+			t.Errorf("Expected length 1. Got %d", b.Len())
+		}
+
+		assertConsistent(t, b)
+	})
+}
+
+func TestBimap_Delete(t *testing.T) {
+	b := FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	if !b.DeleteByKey("a") {
+		t.Error("Expected DeleteByKey(\"a\") to return true")
+	}
+
+	if b.DeleteByKey("a") {
+		t.Error("Expected second DeleteByKey(\"a\") to return false")
+	}
+
+	if _, ok := b.GetByValue(1); ok {
+		t.Error("Expected value 1 to be removed along with key \"a\"")
+	}
+
+	if !b.DeleteByValue(2) {
+		t.Error("Expected DeleteByValue(2) to return true")
+	}
+
+	if b.DeleteByValue(2) {
+		t.Error("Expected second DeleteByValue(2) to return false")
+	}
+
+	if _, ok := b.GetByKey("b"); ok {
+		t.Error("Expected key \"b\" to be removed along with value 2")
+	}
+
+	if b.Len() != 1 {
+		t.Errorf("Expected length 1. Got %d", b.Len())
+	}
+
+	assertConsistent(t, b)
+}
+
+func TestBimap_Clone(t *testing.T) {
+	b := FromMap(map[string]int{"a": 1})
+	clone := b.Clone()
+
+	clone.Set("a", 2)
+	clone.Set("b", 3)
+
+	if v, _ := b.GetByKey("a"); v != 1 || b.Len() != 1 {
+		t.Errorf("Expected original to be unchanged. Got value %d and length %d", v, b.Len())
+	}
+
+	assertConsistent(t, clone)
+}
+
+func TestBimap_FromSyncBimap(t *testing.T) {
+	src := SyncFromMap(map[string]int{"a": 1})
+	dst := FromSyncBimap(src)
+
+	dst.Set("b", 2)
+
+	if src.Len() != 1 {
+		t.Errorf("Expected source to be unchanged. Got length %d", src.Len())
+	}
+
+	if dst.Len() != 2 {
+		t.Errorf("Expected length 2. Got %d", dst.Len())
+	}
+}
@@ -0,0 +1,94 @@
+package bimap
+
+import "sync"
+
+// SyncBimap is a generic one-to-one map with thread-safety.
+//
+// K represents the type of the keys and V the type of the values.
+type SyncBimap[K comparable, V comparable] struct {
+	mu    sync.RWMutex
+	bimap *Bimap[K, V]
+}
+
+// NewSync returns a new empty SyncBimap.
+func NewSync[K comparable, V comparable]() *SyncBimap[K, V] {
+	return &SyncBimap[K, V]{
+		bimap: New[K, V](),
+	}
+}
+
+// SyncFromMap creates a new SyncBimap from the given map. Because a SyncBimap is
+// one-to-one, if several keys share a value only one of them is kept and which
+// one is unspecified.
+func SyncFromMap[K comparable, V comparable](m map[K]V) *SyncBimap[K, V] {
+	return &SyncBimap[K, V]{
+		bimap: FromMap(m),
+	}
+}
+
+// SyncFromBimap creates a new SyncBimap from a given Bimap.
+// This results in a copy so the new map won't be connected to the original.
+func SyncFromBimap[K comparable, V comparable](src *Bimap[K, V]) *SyncBimap[K, V] {
+	return &SyncBimap[K, V]{
+		bimap: src.Clone(),
+	}
+}
+
+// Set maps key to value in both directions. Conflicting pairs are evicted
+// following the same rules as Bimap.Set.
+func (b *SyncBimap[K, V]) Set(key K, value V) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bimap.Set(key, value)
+}
+
+// GetByKey returns the value mapped to the given key and whether it was found.
+func (b *SyncBimap[K, V]) GetByKey(key K) (V, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.bimap.GetByKey(key)
+}
+
+// GetByValue returns the key mapped to the given value and whether it was found.
+func (b *SyncBimap[K, V]) GetByValue(value V) (K, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.bimap.GetByValue(value)
+}
+
+// DeleteByKey removes the pair with the given key. It returns true if the pair was present.
+func (b *SyncBimap[K, V]) DeleteByKey(key K) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.bimap.DeleteByKey(key)
+}
+
+// DeleteByValue removes the pair with the given value. It returns true if the pair was present.
+func (b *SyncBimap[K, V]) DeleteByValue(value V) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.bimap.DeleteByValue(value)
+}
+
+// Len returns the number of pairs in the SyncBimap.
+func (b *SyncBimap[K, V]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.bimap.Len()
+}
+
+// Clone creates a new SyncBimap with the same pairs.
+func (b *SyncBimap[K, V]) Clone() *SyncBimap[K, V] {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return &SyncBimap[K, V]{
+		bimap: b.bimap.Clone(),
+	}
+}
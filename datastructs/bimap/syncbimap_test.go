@@ -0,0 +1,65 @@
+package bimap
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncBimap_NewSync(t *testing.T) {
+	b := NewSync[string, int]()
+
+	if b == nil || b.bimap == nil {
+		t.Fatal("Expected b and b.bimap to not be nil")
+	}
+
+	if b.Len() != 0 {
+		t.Errorf("Expected new SyncBimap to be empty. Got length %d", b.Len())
+	}
+}
+
+func TestSyncBimap_SyncFromBimap(t *testing.T) {
+	src := FromMap(map[string]int{"a": 1})
+	dst := SyncFromBimap(src)
+
+	dst.Set("b", 2)
+
+	if src.Len() != 1 {
+		t.Errorf("Expected source to be unchanged. Got length %d", src.Len())
+	}
+
+	if k, ok := dst.GetByValue(2); !ok || k != "b" {
+		t.Errorf("Expected GetByValue(2) to return (\"b\", true). Got (%q, %v)", k, ok)
+	}
+}
+
+func TestSyncBimap_Concurrency(t *testing.T) {
+	const max = 1000
+
+	b := NewSync[int, int]()
+
+	var wg sync.WaitGroup
+
+	// Every goroutine competes for a small set of keys and values so that
+	// evictions happen concurrently.
+	for i := 0; i < max; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			b.Set(i%10, i%7)
+			b.GetByKey(i % 10)
+			b.GetByValue(i % 7)
+
+			if i%5 == 0 {
+				b.DeleteByKey(i % 10)
+			}
+
+			if i%11 == 0 {
+				b.DeleteByValue(i % 7)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	assertConsistent(t, FromSyncBimap(b))
+}
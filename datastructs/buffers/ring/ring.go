@@ -72,6 +72,13 @@ func (rb *RingBuffer[T]) Peek() (T, bool) {
 	return rb.buffer.Peek()
 }
 
+// DequeueWhile removes and returns elements from the front of the buffer for
+// as long as pred returns true. The first element for which pred returns false
+// stays in the buffer.
+func (rb *RingBuffer[T]) DequeueWhile(pred func(T) bool) []T {
+	return rb.buffer.DequeueWhile(pred)
+}
+
 // ToSlice returns a new slice containing all elements in the buffer in their logical order.
 // The returned slice is independent of the internal buffer state.
 func (rb *RingBuffer[T]) ToSlice() []T {
@@ -84,3 +91,59 @@ func (rb *RingBuffer[T]) Clone() *RingBuffer[T] {
 		buffer: rb.buffer.Clone(),
 	}
 }
+
+// CloneCompact creates a deep copy of the source RingBuffer whose capacity
+// equals its length, with a minimum capacity of 1. This is useful for keeping
+// a buffer that grew large and then drained without holding on to unused memory.
+func (rb *RingBuffer[T]) CloneCompact() *RingBuffer[T] {
+	return &RingBuffer[T]{
+		buffer: rb.buffer.CloneCompact(),
+	}
+}
+
+// EqualsFunc reports whether rb and other hold the same elements in the same
+// logical order, using eq to compare elements.
+func (rb *RingBuffer[T]) EqualsFunc(other *RingBuffer[T], eq func(a, b T) bool) bool {
+	return rb.buffer.EqualsFunc(other.buffer, eq)
+}
+
+// String returns a string representation of the buffer's contents in their
+// logical order, formatted like RingBuffer[1,2,3].
+func (rb *RingBuffer[T]) String() string {
+	return "RingBuffer" + rb.buffer.String()
+}
+
+// ForEach calls f for each element in the buffer in their logical order,
+// from head to tail, without allocating an intermediate slice.
+func (rb *RingBuffer[T]) ForEach(f func(T)) {
+	rb.buffer.ForEach(f)
+}
+
+// ForEachIndexed calls f for each element in the buffer in their logical order,
+// from head to tail, along with the element's logical index (0 being the head).
+func (rb *RingBuffer[T]) ForEachIndexed(f func(int, T)) {
+	rb.buffer.ForEachIndexed(f)
+}
+
+// Resize sets the capacity of the buffer to exactly newCap, reordering the
+// contents so that the oldest element is stored first. It returns an error if
+// newCap is smaller than Len() or less than 1.
+//
+// The buffer keeps resizing itself on later operations, so Dequeue may still
+// shrink it once usage falls below 25% of the new capacity.
+func (rb *RingBuffer[T]) Resize(newCap int) error {
+	return rb.buffer.Resize(newCap)
+}
+
+// Reset removes every element from the buffer while keeping its capacity.
+func (rb *RingBuffer[T]) Reset() {
+	rb.buffer.Reset()
+}
+
+// Equals reports whether both RingBuffers hold the same elements in the same
+// logical order.
+func Equals[T comparable](a, b *RingBuffer[T]) bool {
+	return a.EqualsFunc(b, func(x, y T) bool {
+		return x == y
+	})
+}
@@ -1,6 +1,7 @@
 package ring
 
 import (
+	"fmt"
 	"slices"
 	"testing"
 )
@@ -357,6 +358,51 @@ func TestRingBuffer_Dequeue(t *testing.T) {
 	}
 }
 
+func TestRingBuffer_DequeueWhile(t *testing.T) {
+	t.Run("Stops mid-buffer", func(t *testing.T) {
+		buf := New[int]()
+		buf.Enqueue(1, 2, 3, 10, 4)
+
+		result := buf.DequeueWhile(func(n int) bool { return n < 5 })
+
+		if !slices.Equal(result, []int{1, 2, 3}) {
+			t.Errorf("Expected result to be %#v. Got %#v", []int{1, 2, 3}, result)
+		}
+
+		if !slices.Equal(buf.ToSlice(), []int{10, 4}) {
+			t.Errorf("Expected buffer to be %#v. Got %#v", []int{10, 4}, buf.ToSlice())
+		}
+	})
+
+	t.Run("Drains everything", func(t *testing.T) {
+		buf := New[int]()
+		buf.Enqueue(1, 2, 3)
+
+		result := buf.DequeueWhile(func(n int) bool { return n < 5 })
+
+		if !slices.Equal(result, []int{1, 2, 3}) {
+			t.Errorf("Expected result to be %#v. Got %#v", []int{1, 2, 3}, result)
+		}
+
+		if !buf.IsEmpty() {
+			t.Errorf("Expected buffer to be empty. Got %#v", buf.ToSlice())
+		}
+	})
+
+	t.Run("First element fails", func(t *testing.T) {
+		buf := New[int]()
+		buf.Enqueue(7, 1)
+
+		if result := buf.DequeueWhile(func(n int) bool { return n < 5 }); len(result) != 0 {
+			t.Errorf("Expected result to be empty. Got %#v", result)
+		}
+
+		if buf.Len() != 2 {
+			t.Errorf("Expected buffer length to be 2. Got %d", buf.Len())
+		}
+	})
+}
+
 func TestRingBuffer_Peek(t *testing.T) {
 	scenarios := []struct {
 		name         string
@@ -586,6 +632,162 @@ func TestRingBuffer_Clone(t *testing.T) {
 	}
 }
 
+func TestRingBuffer_CloneCompact(t *testing.T) {
+	src := New[int]()
+	for i := 0; i < 100; i++ {
+		src.Enqueue(i)
+	}
+	for i := 0; i < 90; i++ {
+		_, _ = src.Dequeue()
+	}
+
+	dst := src.CloneCompact()
+
+	if dst.Cap() != dst.Len() {
+		t.Errorf("Expected capacity to equal length %d. Got %d", dst.Len(), dst.Cap())
+	}
+
+	if !slices.Equal(src.ToSlice(), dst.ToSlice()) {
+		t.Errorf("Expected %#v. Got %#v", src.ToSlice(), dst.ToSlice())
+	}
+
+	if empty := New[int](32).CloneCompact(); empty.Cap() != 1 || !empty.IsEmpty() {
+		t.Errorf("Expected empty clone with capacity 1. Got length %d and capacity %d", empty.Len(), empty.Cap())
+	}
+}
+
+func TestRingBuffer_Resize(t *testing.T) {
+	scenarios := []struct {
+		name        string
+		data        []int
+		capacity    int
+		resizeTo    int
+		expectError bool
+		expectedCap int
+	}{
+		{"Grow", []int{1, 2, 3}, 4, 32, false, 32},
+		{"Shrink to fit", []int{1, 2, 3}, 16, 3, false, 3},
+		{"Capacity below length", []int{1, 2, 3}, 16, 2, true, 16},
+		{"Zero capacity", []int{}, 16, 0, true, 16},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			buf := FromSlice(scenario.data, scenario.capacity)
+			err := buf.Resize(scenario.resizeTo)
+
+			if scenario.expectError && err == nil {
+				t.Error("Expected an error. Got none")
+			} else if !scenario.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if buf.Cap() != scenario.expectedCap {
+				t.Errorf("Expected capacity %d. Got %d", scenario.expectedCap, buf.Cap())
+			}
+
+			if !slices.Equal(buf.ToSlice(), scenario.data) {
+				t.Errorf("Expected %#v. Got %#v", scenario.data, buf.ToSlice())
+			}
+		})
+	}
+}
+
+func TestRingBuffer_Reset(t *testing.T) {
+	buf := FromSlice([]int{1, 2, 3}, 16)
+	buf.Reset()
+
+	if !buf.IsEmpty() || buf.Cap() != 16 {
+		t.Errorf("Expected empty buffer with capacity 16. Got %v with capacity %d", buf.ToSlice(), buf.Cap())
+	}
+}
+
+func TestRingBuffer_EqualsFunc(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	// b holds the same logical contents as a but its head has moved.
+	a := FromSlice([]int{3, 4, 5})
+	b := New[int]()
+	b.Enqueue(1, 2, 3, 4, 5)
+	_, _ = b.Dequeue()
+	_, _ = b.Dequeue()
+
+	if !a.EqualsFunc(b, eq) {
+		t.Errorf("Expected %v to equal %v", a, b)
+	}
+
+	if !Equals(a, b) {
+		t.Errorf("Expected Equals(%v, %v) to be true", a, b)
+	}
+
+	b.Enqueue(6)
+	if a.EqualsFunc(b, eq) || Equals(a, b) {
+		t.Errorf("Expected %v to not equal %v", a, b)
+	}
+
+	// Comparator based equality on a type that isn't comparable.
+	s1 := FromSlice([][]int{{1}, {2, 3}})
+	s2 := FromSlice([][]int{{1}, {2, 3}})
+	if !s1.EqualsFunc(s2, slices.Equal[[]int]) {
+		t.Error("Expected slice buffers to be equal")
+	}
+}
+
+func TestRingBuffer_String(t *testing.T) {
+	buf := New[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5)
+
+	if buf.String() != "RingBuffer[3,4,5]" {
+		t.Errorf("Expected %q. Got %q", "RingBuffer[3,4,5]", buf.String())
+	}
+
+	if fmt.Sprint(New[int]()) != "RingBuffer[]" {
+		t.Errorf("Expected %q. Got %q", "RingBuffer[]", fmt.Sprint(New[int]()))
+	}
+}
+
+func TestRingBuffer_ForEach(t *testing.T) {
+	buf := New[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5, 6)
+
+	var result []int
+	buf.ForEach(func(v int) {
+		result = append(result, v)
+	})
+
+	if !slices.Equal(result, []int{3, 4, 5, 6}) {
+		t.Errorf("Expected %#v. Got %#v", []int{3, 4, 5, 6}, result)
+	}
+}
+
+func TestRingBuffer_ForEachIndexed(t *testing.T) {
+	buf := New[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5, 6)
+
+	var indices, values []int
+	buf.ForEachIndexed(func(i, v int) {
+		indices = append(indices, i)
+		values = append(values, v)
+	})
+
+	if !slices.Equal(indices, []int{0, 1, 2, 3}) {
+		t.Errorf("Expected indices %#v. Got %#v", []int{0, 1, 2, 3}, indices)
+	}
+
+	if !slices.Equal(values, []int{3, 4, 5, 6}) {
+		t.Errorf("Expected values %#v. Got %#v", []int{3, 4, 5, 6}, values)
+	}
+}
+
 func makeRange(start, end int) []int {
 	out := make([]int, end-start+1)
 	for i := range out {
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/PsionicAlch/byteforge/internal/datastructs/buffers/ring"
+	"github.com/PsionicAlch/byteforge/internal/functions/utils"
 )
 
 // SyncRingBuffer is a generic dynamically resizable circular buffer
@@ -96,6 +97,36 @@ func (rb *SyncRingBuffer[T]) Peek() (T, bool) {
 	return rb.buffer.Peek()
 }
 
+// DequeueWhile removes and returns elements from the front of the buffer for
+// as long as pred returns true. The first element for which pred returns false
+// stays in the buffer. The whole check-and-remove runs under a single lock, so
+// pred must not call back into the buffer.
+func (rb *SyncRingBuffer[T]) DequeueWhile(pred func(T) bool) []T {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	return rb.buffer.DequeueWhile(pred)
+}
+
+// EnqueueDequeue appends the given values to the end of the buffer and then
+// removes and returns the same number of elements from the front, all under a
+// single lock. This makes it a sliding-window step: new samples go in and the
+// oldest come out. The buffer length is unchanged.
+func (rb *SyncRingBuffer[T]) EnqueueDequeue(values ...T) []T {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	rb.buffer.Enqueue(values...)
+
+	items := make([]T, 0, len(values))
+	for range values {
+		val, _ := rb.buffer.Dequeue()
+		items = append(items, val)
+	}
+
+	return items
+}
+
 // ToSlice returns a new slice containing all elements in the buffer in their logical order.
 // The returned slice is independent of the internal buffer state.
 func (rb *SyncRingBuffer[T]) ToSlice() []T {
@@ -111,3 +142,102 @@ func (rb *SyncRingBuffer[T]) Clone() *SyncRingBuffer[T] {
 		buffer: rb.buffer.Clone(),
 	}
 }
+
+// CloneCompact creates a deep copy of the source SyncRingBuffer whose capacity
+// equals its length, with a minimum capacity of 1. This is useful for keeping
+// a buffer that grew large and then drained without holding on to unused memory.
+func (rb *SyncRingBuffer[T]) CloneCompact() *SyncRingBuffer[T] {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	return &SyncRingBuffer[T]{
+		buffer: rb.buffer.CloneCompact(),
+	}
+}
+
+// Resize sets the capacity of the buffer to exactly newCap, reordering the
+// contents so that the oldest element is stored first. It returns an error if
+// newCap is smaller than Len() or less than 1.
+//
+// The buffer keeps resizing itself on later operations, so Dequeue may still
+// shrink it once usage falls below 25% of the new capacity.
+func (rb *SyncRingBuffer[T]) Resize(newCap int) error {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	return rb.buffer.Resize(newCap)
+}
+
+// Reset removes every element from the buffer while keeping its capacity.
+func (rb *SyncRingBuffer[T]) Reset() {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	rb.buffer.Reset()
+}
+
+// EqualsFunc reports whether rb and other hold the same elements in the same
+// logical order, using eq to compare elements.
+func (rb *SyncRingBuffer[T]) EqualsFunc(other *SyncRingBuffer[T], eq func(a, b T) bool) bool {
+	if rb == other {
+		return true
+	}
+
+	// Lock both in address order to avoid deadlock
+	first, second := utils.SortByAddress(rb, other)
+
+	first.mu.RLock()
+	defer first.mu.RUnlock()
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+
+	return rb.buffer.EqualsFunc(other.buffer, eq)
+}
+
+// String returns a string representation of the buffer's contents in their
+// logical order, formatted like SyncRingBuffer[1,2,3].
+func (rb *SyncRingBuffer[T]) String() string {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	return "SyncRingBuffer" + rb.buffer.String()
+}
+
+// ForEach calls f for each element in the buffer in their logical order,
+// from head to tail.
+//
+// Note: ForEach iterates over a snapshot so the lock isn't held while f runs.
+// Changes made to the buffer during iteration are not visible to f.
+func (rb *SyncRingBuffer[T]) ForEach(f func(T)) {
+	rb.Snapshot().ForEach(f)
+}
+
+// ForEachIndexed calls f for each element in the buffer in their logical order,
+// from head to tail, along with the element's logical index (0 being the head).
+//
+// Note: ForEachIndexed iterates over a snapshot so the lock isn't held while f
+// runs. Changes made to the buffer during iteration are not visible to f.
+func (rb *SyncRingBuffer[T]) ForEachIndexed(f func(int, T)) {
+	rb.Snapshot().ForEachIndexed(f)
+}
+
+// SyncEquals reports whether both SyncRingBuffers hold the same elements in the
+// same logical order.
+func SyncEquals[T comparable](a, b *SyncRingBuffer[T]) bool {
+	return a.EqualsFunc(b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// Snapshot returns a deep copy of the buffer as a plain RingBuffer, preserving
+// its capacity. The snapshot can be read without locking and is not affected by
+// later changes to the source SyncRingBuffer.
+func (rb *SyncRingBuffer[T]) Snapshot() *RingBuffer[T] {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	return &RingBuffer[T]{
+		buffer: rb.buffer.Clone(),
+	}
+}
@@ -290,6 +290,131 @@ func TestSyncRingBuffer_Dequeue(t *testing.T) {
 	}
 }
 
+func TestSyncRingBuffer_DequeueWhile(t *testing.T) {
+	const max = 1000
+
+	buf := NewSync[int]()
+	for i := 0; i < max; i++ {
+		buf.Enqueue(i)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	drained := 0
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			count := 0
+			result := buf.DequeueWhile(func(n int) bool {
+				count++
+				return n < max/2 && count <= 20
+			})
+
+			for j := 1; j < len(result); j++ {
+				if result[j] != result[j-1]+1 {
+					t.Errorf("Expected a contiguous run of elements. Got %#v", result)
+					break
+				}
+			}
+
+			mu.Lock()
+			drained += len(result)
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	if drained != max/2 {
+		t.Errorf("Expected %d elements to be drained. Got %d", max/2, drained)
+	}
+
+	if front, _ := buf.Peek(); front != max/2 {
+		t.Errorf("Expected front of the buffer to be %d. Got %d", max/2, front)
+	}
+}
+
+func TestSyncRingBuffer_EnqueueDequeue(t *testing.T) {
+	t.Run("Returns the previous front elements", func(t *testing.T) {
+		buf := SyncFromSlice([]int{1, 2, 3, 4})
+
+		result := buf.EnqueueDequeue(5, 6)
+
+		if !slices.Equal(result, []int{1, 2}) {
+			t.Errorf("Expected result to be %#v. Got %#v", []int{1, 2}, result)
+		}
+
+		if !slices.Equal(buf.ToSlice(), []int{3, 4, 5, 6}) {
+			t.Errorf("Expected buffer to be %#v. Got %#v", []int{3, 4, 5, 6}, buf.ToSlice())
+		}
+	})
+
+	t.Run("Empty buffer returns the new values", func(t *testing.T) {
+		buf := NewSync[int]()
+
+		result := buf.EnqueueDequeue(1, 2)
+
+		if !slices.Equal(result, []int{1, 2}) {
+			t.Errorf("Expected result to be %#v. Got %#v", []int{1, 2}, result)
+		}
+
+		if !buf.IsEmpty() {
+			t.Errorf("Expected buffer to be empty. Got %#v", buf.ToSlice())
+		}
+	})
+
+	t.Run("No values", func(t *testing.T) {
+		buf := SyncFromSlice([]int{1, 2})
+
+		if result := buf.EnqueueDequeue(); len(result) != 0 {
+			t.Errorf("Expected result to be empty. Got %#v", result)
+		}
+
+		if !slices.Equal(buf.ToSlice(), []int{1, 2}) {
+			t.Errorf("Expected buffer to be %#v. Got %#v", []int{1, 2}, buf.ToSlice())
+		}
+	})
+
+	t.Run("Concurrent steps keep the window size", func(t *testing.T) {
+		const window = 16
+
+		buf := NewSync[int](window)
+		for i := 0; i < window; i++ {
+			buf.Enqueue(-1)
+		}
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 500; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				if result := buf.EnqueueDequeue(i, i); len(result) != 2 {
+					t.Errorf("Expected 2 elements. Got %#v", result)
+				}
+
+				if n := buf.Len(); n != window {
+					t.Errorf("Expected buffer length to be %d. Got %d", window, n)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		result := buf.ToSlice()
+		for i := 0; i < len(result); i += 2 {
+			if result[i] != result[i+1] {
+				t.Errorf("Expected values to stay paired. Got %#v", result)
+				break
+			}
+		}
+	})
+}
+
 func TestSyncRingBuffer_Peek(t *testing.T) {
 	buf := SyncFromSlice([]int{1, 2, 3, 4, 5})
 	expectedValue := 1
@@ -371,3 +496,323 @@ func TestSyncRingBuffer_Clone(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestSyncRingBuffer_CloneCompact(t *testing.T) {
+	src := NewSync[int]()
+	for i := 0; i < 100; i++ {
+		src.Enqueue(i)
+	}
+	for i := 0; i < 90; i++ {
+		_, _ = src.Dequeue()
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			dst := src.CloneCompact()
+
+			if dst.Cap() != dst.Len() {
+				t.Errorf("Expected capacity to equal length %d. Got %d", dst.Len(), dst.Cap())
+			}
+
+			if !slices.Equal(src.ToSlice(), dst.ToSlice()) {
+				t.Errorf("Expected %#v. Got %#v", src.ToSlice(), dst.ToSlice())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncRingBuffer_Resize(t *testing.T) {
+	buf := SyncFromSlice([]int{1, 2, 3}, 4)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			if err := buf.Resize(8 + i%8); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			if !slices.Equal(buf.ToSlice(), []int{1, 2, 3}) {
+				t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3}, buf.ToSlice())
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := buf.Resize(3); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if buf.Cap() != 3 {
+		t.Errorf("Expected capacity 3. Got %d", buf.Cap())
+	}
+
+	if err := buf.Resize(2); err == nil {
+		t.Error("Expected an error when resizing below the current length")
+	}
+}
+
+func TestSyncRingBuffer_ResizeAndReset(t *testing.T) {
+	buf := NewSync[int](16)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			buf.Enqueue(i)
+			if err := buf.Resize(256); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			buf.Reset()
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			snapshot := buf.Snapshot()
+			if snapshot.Len() > snapshot.Cap() {
+				t.Errorf("Length %d exceeds capacity %d", snapshot.Len(), snapshot.Cap())
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	buf.Reset()
+	if !buf.IsEmpty() || buf.Cap() != 256 {
+		t.Errorf("Expected empty buffer with capacity 256. Got %v with capacity %d", buf.ToSlice(), buf.Cap())
+	}
+
+	buf.Enqueue(1, 2, 3)
+	if err := buf.Resize(2); err == nil {
+		t.Error("Expected an error when resizing below the current length")
+	}
+}
+
+func TestSyncRingBuffer_EqualsFunc(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	a := SyncFromSlice([]int{3, 4, 5})
+	b := NewSync[int]()
+	b.Enqueue(1, 2, 3, 4, 5)
+	_, _ = b.Dequeue()
+	_, _ = b.Dequeue()
+	c := SyncFromSlice([]int{3, 4, 6})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if !a.EqualsFunc(b, eq) || !b.EqualsFunc(a, eq) {
+				t.Error("Expected a and b to be equal.")
+			}
+
+			if !SyncEquals(a, b) {
+				t.Error("Expected SyncEquals(a, b) to be true.")
+			}
+
+			if SyncEquals(a, c) {
+				t.Error("Expected SyncEquals(a, c) to be false.")
+			}
+
+			if !a.EqualsFunc(a, eq) {
+				t.Error("Expected a to equal itself.")
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncRingBuffer_String(t *testing.T) {
+	buf := SyncFromSlice([]int{1, 2, 3})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if buf.String() != "SyncRingBuffer[1,2,3]" {
+				t.Errorf("Expected %q. Got %q", "SyncRingBuffer[1,2,3]", buf.String())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncRingBuffer_ForEach(t *testing.T) {
+	buf := NewSync[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5, 6)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var result []int
+			buf.ForEach(func(v int) {
+				result = append(result, v)
+			})
+
+			if !slices.Equal(result, []int{3, 4, 5, 6}) {
+				t.Errorf("Expected %#v. Got %#v", []int{3, 4, 5, 6}, result)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// The lock isn't held during the callback, so it may safely use the buffer.
+	buf.ForEach(func(v int) {
+		buf.Enqueue(v)
+	})
+
+	if buf.Len() != 8 {
+		t.Errorf("Expected length 8. Got %d", buf.Len())
+	}
+}
+
+func TestSyncRingBuffer_ForEachIndexed(t *testing.T) {
+	buf := NewSync[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5, 6)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var indices, values []int
+			buf.ForEachIndexed(func(i, v int) {
+				indices = append(indices, i)
+				values = append(values, v)
+			})
+
+			if !slices.Equal(indices, []int{0, 1, 2, 3}) {
+				t.Errorf("Expected indices %#v. Got %#v", []int{0, 1, 2, 3}, indices)
+			}
+
+			if !slices.Equal(values, []int{3, 4, 5, 6}) {
+				t.Errorf("Expected values %#v. Got %#v", []int{3, 4, 5, 6}, values)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncRingBuffer_Snapshot(t *testing.T) {
+	t.Run("Snapshot is independent of source", func(t *testing.T) {
+		src := SyncFromSlice([]int{1, 2, 3}, 16)
+		snapshot := src.Snapshot()
+
+		if snapshot.Cap() != src.Cap() {
+			t.Errorf("Expected snapshot capacity to be %d. Got %d", src.Cap(), snapshot.Cap())
+		}
+
+		src.Enqueue(4)
+		_, _ = snapshot.Dequeue()
+
+		if !slices.Equal(snapshot.ToSlice(), []int{2, 3}) {
+			t.Errorf("Expected snapshot to be %#v. Got %#v", []int{2, 3}, snapshot.ToSlice())
+		}
+
+		if !slices.Equal(src.ToSlice(), []int{1, 2, 3, 4}) {
+			t.Errorf("Expected source to be %#v. Got %#v", []int{1, 2, 3, 4}, src.ToSlice())
+		}
+	})
+
+	t.Run("Snapshots are consistent under concurrent mutation", func(t *testing.T) {
+		const max = 10000
+
+		src := NewSync[int]()
+
+		var wg sync.WaitGroup
+
+		// The producer enqueues increasing numbers and the consumer dequeues
+		// from the front, so every consistent state is a run of consecutive numbers.
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < max; i++ {
+				src.Enqueue(i)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < max; i++ {
+				_, _ = src.Dequeue()
+			}
+		}()
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					snapshot := src.Snapshot()
+					items := snapshot.ToSlice()
+
+					if snapshot.Len() != len(items) {
+						t.Errorf("Expected snapshot length %d to match its contents %d", snapshot.Len(), len(items))
+					}
+
+					for k := 1; k < len(items); k++ {
+						if items[k] != items[k-1]+1 {
+							t.Errorf("Snapshot is inconsistent: %d followed by %d", items[k-1], items[k])
+							return
+						}
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+	})
+}
@@ -0,0 +1,167 @@
+// Package list provides a generic doubly-linked list with constant time
+// insertion and removal at both ends and at any element it holds a handle to.
+package list
+
+import "iter"
+
+// Element is a handle to a value stored in a LinkedList. It stays valid until
+// the value is removed from the list, and can be passed to Remove to delete
+// that value in constant time.
+type Element[T any] struct {
+	value      T
+	prev, next *Element[T]
+	list       *LinkedList[T]
+}
+
+// Value returns the value stored in the Element.
+func (e *Element[T]) Value() T {
+	return e.value
+}
+
+// LinkedList is a generic doubly-linked list. Unlike the ring buffer it never
+// needs to resize, and elements keep a stable identity for as long as they
+// are in the list.
+//
+// T represents the type of elements stored in the list.
+type LinkedList[T any] struct {
+	head, tail *Element[T]
+	size       int
+}
+
+// New returns a new empty LinkedList.
+func New[T any]() *LinkedList[T] {
+	return &LinkedList[T]{}
+}
+
+// FromSlice creates a new LinkedList holding the elements of the given slice
+// in the same order.
+func FromSlice[T any, A ~[]T](s A) *LinkedList[T] {
+	l := New[T]()
+	for _, v := range s {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+// FromSyncLinkedList creates a new LinkedList from a given SyncLinkedList.
+// This results in a copy so the new list won't be connected to the original.
+func FromSyncLinkedList[T any](src *SyncLinkedList[T]) *LinkedList[T] {
+	return FromSlice(src.ToSlice())
+}
+
+// Len returns the number of elements in the list.
+func (l *LinkedList[T]) Len() int {
+	return l.size
+}
+
+// IsEmpty returns true if the list contains no elements.
+func (l *LinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// PushFront inserts a value at the front of the list and returns its Element.
+func (l *LinkedList[T]) PushFront(v T) *Element[T] {
+	e := &Element[T]{value: v, next: l.head, list: l}
+
+	if l.head != nil {
+		l.head.prev = e
+	} else {
+		l.tail = e
+	}
+
+	l.head = e
+	l.size++
+
+	return e
+}
+
+// PushBack inserts a value at the back of the list and returns its Element.
+func (l *LinkedList[T]) PushBack(v T) *Element[T] {
+	e := &Element[T]{value: v, prev: l.tail, list: l}
+
+	if l.tail != nil {
+		l.tail.next = e
+	} else {
+		l.head = e
+	}
+
+	l.tail = e
+	l.size++
+
+	return e
+}
+
+// PopFront removes and returns the value at the front of the list.
+// If the list is empty, it returns the zero value of T and false.
+func (l *LinkedList[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+
+	return l.Remove(l.head)
+}
+
+// PopBack removes and returns the value at the back of the list.
+// If the list is empty, it returns the zero value of T and false.
+func (l *LinkedList[T]) PopBack() (T, bool) {
+	if l.tail == nil {
+		var zero T
+		return zero, false
+	}
+
+	return l.Remove(l.tail)
+}
+
+// Remove deletes the given Element from the list and returns its value.
+// If the Element is nil or does not belong to this list (for example because it
+// was already removed), the list is left unchanged and the zero value of T and
+// false are returned.
+func (l *LinkedList[T]) Remove(e *Element[T]) (T, bool) {
+	if e == nil || e.list != l {
+		var zero T
+		return zero, false
+	}
+
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		l.head = e.next
+	}
+
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		l.tail = e.prev
+	}
+
+	// Clear the links so the removed element can't be used to reach the list.
+	e.prev = nil
+	e.next = nil
+	e.list = nil
+	l.size--
+
+	return e.value, true
+}
+
+// Iter returns an iterator over the list's values from front to back.
+func (l *LinkedList[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.head; e != nil; e = e.next {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a new slice containing all values in the list from front to back.
+func (l *LinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, l.size)
+	for e := l.head; e != nil; e = e.next {
+		result = append(result, e.value)
+	}
+
+	return result
+}
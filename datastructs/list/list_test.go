@@ -0,0 +1,204 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLinkedList_New(t *testing.T) {
+	l := New[int]()
+
+	if l == nil {
+		t.Fatal("Expected l to not be nil")
+	}
+
+	if l.Len() != 0 || !l.IsEmpty() {
+		t.Errorf("Expected new list to be empty. Got length %d", l.Len())
+	}
+}
+
+func TestLinkedList_FromSlice(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	l := FromSlice(data)
+
+	if l.Len() != len(data) {
+		t.Errorf("Expected length %d. Got %d", len(data), l.Len())
+	}
+
+	if !slices.Equal(l.ToSlice(), data) {
+		t.Errorf("Expected %#v. Got %#v", data, l.ToSlice())
+	}
+}
+
+func TestLinkedList_FromSyncLinkedList(t *testing.T) {
+	src := SyncFromSlice([]int{1, 2, 3})
+	dst := FromSyncLinkedList(src)
+
+	dst.PushBack(4)
+
+	if !slices.Equal(src.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected source to be unchanged. Got %#v", src.ToSlice())
+	}
+
+	if !slices.Equal(dst.ToSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3, 4}, dst.ToSlice())
+	}
+}
+
+func TestLinkedList_Push(t *testing.T) {
+	l := New[int]()
+
+	l.PushBack(2)
+	l.PushFront(1)
+	l.PushBack(3)
+	l.PushFront(0)
+
+	expected := []int{0, 1, 2, 3}
+	if !slices.Equal(l.ToSlice(), expected) {
+		t.Errorf("Expected %#v. Got %#v", expected, l.ToSlice())
+	}
+
+	if l.Len() != len(expected) {
+		t.Errorf("Expected length %d. Got %d", len(expected), l.Len())
+	}
+}
+
+func TestLinkedList_Pop(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3, 4})
+
+	if v, ok := l.PopFront(); !ok || v != 1 {
+		t.Errorf("Expected PopFront to return (1, true). Got (%d, %v)", v, ok)
+	}
+
+	if v, ok := l.PopBack(); !ok || v != 4 {
+		t.Errorf("Expected PopBack to return (4, true). Got (%d, %v)", v, ok)
+	}
+
+	if !slices.Equal(l.ToSlice(), []int{2, 3}) {
+		t.Errorf("Expected %#v. Got %#v", []int{2, 3}, l.ToSlice())
+	}
+
+	if v, ok := l.PopBack(); !ok || v != 3 {
+		t.Errorf("Expected PopBack to return (3, true). Got (%d, %v)", v, ok)
+	}
+
+	if v, ok := l.PopFront(); !ok || v != 2 {
+		t.Errorf("Expected PopFront to return (2, true). Got (%d, %v)", v, ok)
+	}
+
+	if _, ok := l.PopFront(); ok {
+		t.Error("Expected PopFront on empty list to return false")
+	}
+
+	if _, ok := l.PopBack(); ok {
+		t.Error("Expected PopBack on empty list to return false")
+	}
+
+	// The list must still be usable after being emptied.
+	l.PushBack(5)
+	if !slices.Equal(l.ToSlice(), []int{5}) {
+		t.Errorf("Expected %#v. Got %#v", []int{5}, l.ToSlice())
+	}
+}
+
+func TestLinkedList_Remove(t *testing.T) {
+	t.Run("Remove from the middle", func(t *testing.T) {
+		l := New[int]()
+		l.PushBack(1)
+		middle := l.PushBack(2)
+		l.PushBack(3)
+
+		if v, ok := l.Remove(middle); !ok || v != 2 {
+			t.Errorf("Expected Remove to return (2, true). Got (%d, %v)", v, ok)
+		}
+
+		if !slices.Equal(l.ToSlice(), []int{1, 3}) {
+			t.Errorf("Expected %#v. Got %#v", []int{1, 3}, l.ToSlice())
+		}
+
+		if l.Len() != 2 {
+			t.Errorf("Expected length 2. Got %d", l.Len())
+		}
+	})
+
+	t.Run("Remove the ends", func(t *testing.T) {
+		l := New[int]()
+		first := l.PushBack(1)
+		l.PushBack(2)
+		last := l.PushBack(3)
+
+		l.Remove(first)
+		l.Remove(last)
+
+		if !slices.Equal(l.ToSlice(), []int{2}) {
+			t.Errorf("Expected %#v. Got %#v", []int{2}, l.ToSlice())
+		}
+
+		if v, ok := l.PopBack(); !ok || v != 2 {
+			t.Errorf("Expected PopBack to return (2, true). Got (%d, %v)", v, ok)
+		}
+	})
+
+	t.Run("Remove twice", func(t *testing.T) {
+		l := New[int]()
+		e := l.PushBack(1)
+		l.PushBack(2)
+
+		l.Remove(e)
+
+		if _, ok := l.Remove(e); ok {
+			t.Error("Expected removing an element twice to return false")
+		}
+
+		if l.Len() != 1 {
+			t.Errorf("Expected length 1. Got %d", l.Len())
+		}
+	})
+
+	t.Run("Remove element from another list", func(t *testing.T) {
+		l1 := New[int]()
+		l2 := New[int]()
+		l1.PushBack(1)
+		e := l2.PushBack(2)
+
+		if _, ok := l1.Remove(e); ok {
+			t.Error("Expected removing another list's element to return false")
+		}
+
+		if l1.Len() != 1 || l2.Len() != 1 {
+			t.Errorf("Expected both lists to be unchanged. Got lengths %d and %d", l1.Len(), l2.Len())
+		}
+	})
+
+	t.Run("Remove nil", func(t *testing.T) {
+		l := FromSlice([]int{1})
+
+		if _, ok := l.Remove(nil); ok {
+			t.Error("Expected removing nil to return false")
+		}
+	})
+}
+
+func TestLinkedList_Iter(t *testing.T) {
+	l := New[int]()
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+
+	result := slices.Collect(l.Iter())
+	if !slices.Equal(result, []int{1, 2, 3}) {
+		t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3}, result)
+	}
+
+	var partial []int
+	for v := range l.Iter() {
+		if v == 2 {
+			break
+		}
+		partial = append(partial, v)
+	}
+
+	if !slices.Equal(partial, []int{1}) {
+		t.Errorf("Expected early break to yield %#v. Got %#v", []int{1}, partial)
+	}
+}
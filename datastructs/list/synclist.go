@@ -0,0 +1,123 @@
+package list
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncLinkedList is a generic doubly-linked list with thread-safety.
+//
+// T represents the type of elements stored in the list.
+type SyncLinkedList[T any] struct {
+	mu   sync.RWMutex
+	list *LinkedList[T]
+}
+
+// NewSync returns a new empty SyncLinkedList.
+func NewSync[T any]() *SyncLinkedList[T] {
+	return &SyncLinkedList[T]{
+		list: New[T](),
+	}
+}
+
+// SyncFromSlice creates a new SyncLinkedList holding the elements of the given
+// slice in the same order.
+func SyncFromSlice[T any, A ~[]T](s A) *SyncLinkedList[T] {
+	return &SyncLinkedList[T]{
+		list: FromSlice(s),
+	}
+}
+
+// SyncFromLinkedList creates a new SyncLinkedList from a given LinkedList.
+// This results in a copy so the new list won't be connected to the original.
+func SyncFromLinkedList[T any](src *LinkedList[T]) *SyncLinkedList[T] {
+	return &SyncLinkedList[T]{
+		list: FromSlice(src.ToSlice()),
+	}
+}
+
+// Len returns the number of elements in the list.
+func (l *SyncLinkedList[T]) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.Len()
+}
+
+// IsEmpty returns true if the list contains no elements.
+func (l *SyncLinkedList[T]) IsEmpty() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.IsEmpty()
+}
+
+// PushFront inserts a value at the front of the list and returns its Element.
+func (l *SyncLinkedList[T]) PushFront(v T) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.PushFront(v)
+}
+
+// PushBack inserts a value at the back of the list and returns its Element.
+func (l *SyncLinkedList[T]) PushBack(v T) *Element[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.PushBack(v)
+}
+
+// PopFront removes and returns the value at the front of the list.
+// If the list is empty, it returns the zero value of T and false.
+func (l *SyncLinkedList[T]) PopFront() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.PopFront()
+}
+
+// PopBack removes and returns the value at the back of the list.
+// If the list is empty, it returns the zero value of T and false.
+func (l *SyncLinkedList[T]) PopBack() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.PopBack()
+}
+
+// Remove deletes the given Element from the list and returns its value.
+// If the Element is nil or does not belong to this list (for example because it
+// was already removed), the list is left unchanged and the zero value of T and
+// false are returned.
+func (l *SyncLinkedList[T]) Remove(e *Element[T]) (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.Remove(e)
+}
+
+// Iter returns an iterator over the list's values from front to back.
+//
+// Note: Iter returns a snapshot iterator (not live-updated)
+func (l *SyncLinkedList[T]) Iter() iter.Seq[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	snapshot := l.list.ToSlice()
+	return func(yield func(T) bool) {
+		for _, item := range snapshot {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a new slice containing all values in the list from front to back.
+func (l *SyncLinkedList[T]) ToSlice() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.ToSlice()
+}
@@ -0,0 +1,154 @@
+package list
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncLinkedList_NewSync(t *testing.T) {
+	l := NewSync[int]()
+
+	if l == nil || l.list == nil {
+		t.Fatal("Expected l and l.list to not be nil")
+	}
+
+	if !l.IsEmpty() {
+		t.Errorf("Expected new list to be empty. Got length %d", l.Len())
+	}
+}
+
+func TestSyncLinkedList_SyncFromLinkedList(t *testing.T) {
+	src := FromSlice([]int{1, 2, 3})
+	dst := SyncFromLinkedList(src)
+
+	dst.PushBack(4)
+
+	if !slices.Equal(src.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected source to be unchanged. Got %#v", src.ToSlice())
+	}
+
+	if !slices.Equal(dst.ToSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3, 4}, dst.ToSlice())
+	}
+}
+
+func TestSyncLinkedList_PushPop(t *testing.T) {
+	const max = 1000
+
+	l := NewSync[int]()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < max; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				l.PushFront(i)
+			} else {
+				l.PushBack(i)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if l.Len() != max {
+		t.Fatalf("Expected length %d. Got %d", max, l.Len())
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]int)
+
+	for i := 0; i < max; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			var v int
+			var ok bool
+			if i%2 == 0 {
+				v, ok = l.PopFront()
+			} else {
+				v, ok = l.PopBack()
+			}
+
+			if !ok {
+				t.Error("Expected pop to succeed")
+				return
+			}
+
+			mu.Lock()
+			seen[v]++
+			mu.Unlock()
+		}(i)
+	}
+
+	wg.Wait()
+
+	if !l.IsEmpty() {
+		t.Errorf("Expected list to be empty. Got length %d", l.Len())
+	}
+
+	for i := 0; i < max; i++ {
+		if seen[i] != 1 {
+			t.Errorf("Expected %d to be popped exactly once. Got %d", i, seen[i])
+		}
+	}
+}
+
+func TestSyncLinkedList_Remove(t *testing.T) {
+	l := NewSync[int]()
+
+	var elements []*Element[int]
+	for i := 0; i < 100; i++ {
+		elements = append(elements, l.PushBack(i))
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	removed := 0
+
+	// Every element is removed concurrently by two goroutines, only one may succeed.
+	for _, e := range elements {
+		for j := 0; j < 2; j++ {
+			wg.Add(1)
+			go func(e *Element[int]) {
+				defer wg.Done()
+				if _, ok := l.Remove(e); ok {
+					mu.Lock()
+					removed++
+					mu.Unlock()
+				}
+			}(e)
+		}
+	}
+
+	wg.Wait()
+
+	if removed != len(elements) {
+		t.Errorf("Expected %d successful removals. Got %d", len(elements), removed)
+	}
+
+	if !l.IsEmpty() {
+		t.Errorf("Expected list to be empty. Got length %d", l.Len())
+	}
+}
+
+func TestSyncLinkedList_Iter(t *testing.T) {
+	l := SyncFromSlice([]int{1, 2, 3})
+
+	// Take snapshot iterator before the list changes.
+	seq := l.Iter()
+	l.PushBack(4)
+
+	result := slices.Collect(seq)
+	if !slices.Equal(result, []int{1, 2, 3}) {
+		t.Errorf("Expected snapshot %#v. Got %#v", []int{1, 2, 3}, result)
+	}
+
+	if !slices.Equal(slices.Collect(l.Iter()), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3, 4}, l.ToSlice())
+	}
+}
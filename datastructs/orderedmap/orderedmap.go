@@ -0,0 +1,119 @@
+// Package orderedmap provides a generic map that remembers the order in which
+// keys were first inserted, making iteration deterministic.
+package orderedmap
+
+import (
+	"iter"
+
+	"github.com/PsionicAlch/byteforge/datastructs/list"
+)
+
+// entry holds a value along with the list element that records its key's position.
+type entry[K comparable, V any] struct {
+	value V
+	elem  *list.Element[K]
+}
+
+// OrderedMap is a generic map that iterates over its keys in insertion order.
+// Lookups, insertions and deletions all run in constant time.
+//
+// K represents the type of the keys and V the type of the values.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*entry[K, V]
+	order   *list.LinkedList[K]
+}
+
+// New returns a new empty OrderedMap.
+func New[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		entries: make(map[K]*entry[K, V]),
+		order:   list.New[K](),
+	}
+}
+
+// FromSyncOrderedMap creates a new OrderedMap from a given SyncOrderedMap.
+// This results in a copy so the new map won't be connected to the original.
+func FromSyncOrderedMap[K comparable, V any](src *SyncOrderedMap[K, V]) *OrderedMap[K, V] {
+	src.mu.RLock()
+	defer src.mu.RUnlock()
+
+	return src.m.Clone()
+}
+
+// Set stores the value for the given key. If the key already exists its value
+// is replaced but the key keeps its original position in the iteration order.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.entries[key]; ok {
+		e.value = value
+		return
+	}
+
+	m.entries[key] = &entry[K, V]{
+		value: value,
+		elem:  m.order.PushBack(key),
+	}
+}
+
+// Get returns the value stored for the given key and whether it was found.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.entries[key]; ok {
+		return e.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Delete removes the given key from the map. It returns true if the key was present.
+// Setting the key again afterwards places it at the end of the iteration order.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	m.order.Remove(e.elem)
+	delete(m.entries, key)
+
+	return true
+}
+
+// Len returns the number of keys in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Keys returns a new slice containing the map's keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	return m.order.ToSlice()
+}
+
+// Iter returns an iterator over the map's key-value pairs in insertion order.
+//
+// The keys are snapshotted when iteration starts, so it is safe to delete keys
+// from inside the loop. Keys deleted before they are reached are skipped, and
+// keys added during iteration are not visited.
+func (m *OrderedMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, key := range m.order.ToSlice() {
+			e, ok := m.entries[key]
+			if !ok {
+				continue
+			}
+
+			if !yield(key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Clone creates a new OrderedMap with the same key-value pairs in the same order.
+func (m *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	clone := New[K, V]()
+	for key, value := range m.Iter() {
+		clone.Set(key, value)
+	}
+
+	return clone
+}
@@ -0,0 +1,198 @@
+package orderedmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderedMap_New(t *testing.T) {
+	m := New[string, int]()
+
+	if m == nil || m.entries == nil || m.order == nil {
+		t.Fatal("Expected m and its internals to not be nil")
+	}
+
+	if m.Len() != 0 {
+		t.Errorf("Expected new map to be empty. Got length %d", m.Len())
+	}
+}
+
+func TestOrderedMap_SetGet(t *testing.T) {
+	m := New[string, int]()
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected Get(\"a\") to return (1, true). Got (%d, %v)", v, ok)
+	}
+
+	if v, ok := m.Get("b"); !ok || v != 2 {
+		t.Errorf("Expected Get(\"b\") to return (2, true). Got (%d, %v)", v, ok)
+	}
+
+	if v, ok := m.Get("c"); ok || v != 0 {
+		t.Errorf("Expected Get(\"c\") to return (0, false). Got (%d, %v)", v, ok)
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Expected length 2. Got %d", m.Len())
+	}
+}
+
+func TestOrderedMap_Order(t *testing.T) {
+	m := New[string, int]()
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	if keys := m.Keys(); !slices.Equal(keys, []string{"c", "a", "b"}) {
+		t.Errorf("Expected keys %#v. Got %#v", []string{"c", "a", "b"}, keys)
+	}
+
+	// Updating an existing key keeps its position.
+	m.Set("c", 30)
+
+	if keys := m.Keys(); !slices.Equal(keys, []string{"c", "a", "b"}) {
+		t.Errorf("Expected keys %#v after update. Got %#v", []string{"c", "a", "b"}, keys)
+	}
+
+	if v, _ := m.Get("c"); v != 30 {
+		t.Errorf("Expected updated value 30. Got %d", v)
+	}
+
+	// Deleting and re-setting a key moves it to the end.
+	if !m.Delete("a") {
+		t.Error("Expected Delete(\"a\") to return true")
+	}
+
+	if m.Delete("a") {
+		t.Error("Expected second Delete(\"a\") to return false")
+	}
+
+	m.Set("d", 4)
+	m.Set("a", 10)
+
+	expectedKeys := []string{"c", "b", "d", "a"}
+	expectedValues := []int{30, 2, 4, 10}
+
+	var keys []string
+	var values []int
+	for k, v := range m.Iter() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	if !slices.Equal(keys, expectedKeys) {
+		t.Errorf("Expected keys %#v. Got %#v", expectedKeys, keys)
+	}
+
+	if !slices.Equal(values, expectedValues) {
+		t.Errorf("Expected values %#v. Got %#v", expectedValues, values)
+	}
+
+	if m.Len() != len(expectedKeys) {
+		t.Errorf("Expected length %d. Got %d", len(expectedKeys), m.Len())
+	}
+}
+
+func TestOrderedMap_Iter(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 5; i++ {
+		m.Set(i, i*i)
+	}
+
+	var keys []int
+	for k := range m.Iter() {
+		if k == 3 {
+			break
+		}
+		keys = append(keys, k)
+	}
+
+	if !slices.Equal(keys, []int{0, 1, 2}) {
+		t.Errorf("Expected early break to yield %#v. Got %#v", []int{0, 1, 2}, keys)
+	}
+}
+
+func TestOrderedMap_IterDelete(t *testing.T) {
+	t.Run("Delete the current key", func(t *testing.T) {
+		m := New[int, int]()
+		for i := 0; i < 5; i++ {
+			m.Set(i, i*i)
+		}
+
+		var keys []int
+		for k := range m.Iter() {
+			keys = append(keys, k)
+			m.Delete(k)
+		}
+
+		if !slices.Equal(keys, []int{0, 1, 2, 3, 4}) {
+			t.Errorf("Expected every key to be visited. Got %#v", keys)
+		}
+
+		if m.Len() != 0 {
+			t.Errorf("Expected map to be empty. Got %#v", m.Keys())
+		}
+	})
+
+	t.Run("Delete a later key", func(t *testing.T) {
+		m := New[int, int]()
+		for i := 0; i < 5; i++ {
+			m.Set(i, i*i)
+		}
+
+		var keys []int
+		for k := range m.Iter() {
+			keys = append(keys, k)
+			if k == 1 {
+				m.Delete(3)
+			}
+		}
+
+		if !slices.Equal(keys, []int{0, 1, 2, 4}) {
+			t.Errorf("Expected deleted key to be skipped. Got %#v", keys)
+		}
+	})
+}
+
+func TestOrderedMap_Clone(t *testing.T) {
+	m := New[string, int]()
+	m.Set("x", 1)
+	m.Set("y", 2)
+
+	clone := m.Clone()
+	clone.Set("z", 3)
+	clone.Set("x", 10)
+
+	if !slices.Equal(m.Keys(), []string{"x", "y"}) {
+		t.Errorf("Expected original keys to be unchanged. Got %#v", m.Keys())
+	}
+
+	if v, _ := m.Get("x"); v != 1 {
+		t.Errorf("Expected original value to be unchanged. Got %d", v)
+	}
+
+	if !slices.Equal(clone.Keys(), []string{"x", "y", "z"}) {
+		t.Errorf("Expected clone keys %#v. Got %#v", []string{"x", "y", "z"}, clone.Keys())
+	}
+}
+
+func TestOrderedMap_FromSyncOrderedMap(t *testing.T) {
+	src := NewSync[string, int]()
+	src.Set("a", 1)
+	src.Set("b", 2)
+
+	dst := FromSyncOrderedMap(src)
+	dst.Set("c", 3)
+
+	if src.Len() != 2 {
+		t.Errorf("Expected source to be unchanged. Got length %d", src.Len())
+	}
+
+	if !slices.Equal(dst.Keys(), []string{"a", "b", "c"}) {
+		t.Errorf("Expected keys %#v. Got %#v", []string{"a", "b", "c"}, dst.Keys())
+	}
+}
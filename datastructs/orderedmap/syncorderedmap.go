@@ -0,0 +1,92 @@
+package orderedmap
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncOrderedMap is a generic map that iterates over its keys in insertion
+// order with thread-safety.
+//
+// K represents the type of the keys and V the type of the values.
+type SyncOrderedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  *OrderedMap[K, V]
+}
+
+// NewSync returns a new empty SyncOrderedMap.
+func NewSync[K comparable, V any]() *SyncOrderedMap[K, V] {
+	return &SyncOrderedMap[K, V]{
+		m: New[K, V](),
+	}
+}
+
+// SyncFromOrderedMap creates a new SyncOrderedMap from a given OrderedMap.
+// This results in a copy so the new map won't be connected to the original.
+func SyncFromOrderedMap[K comparable, V any](src *OrderedMap[K, V]) *SyncOrderedMap[K, V] {
+	return &SyncOrderedMap[K, V]{
+		m: src.Clone(),
+	}
+}
+
+// Set stores the value for the given key. If the key already exists its value
+// is replaced but the key keeps its original position in the iteration order.
+func (m *SyncOrderedMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Set(key, value)
+}
+
+// Get returns the value stored for the given key and whether it was found.
+func (m *SyncOrderedMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Get(key)
+}
+
+// Delete removes the given key from the map. It returns true if the key was present.
+func (m *SyncOrderedMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.m.Delete(key)
+}
+
+// Len returns the number of keys in the map.
+func (m *SyncOrderedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Len()
+}
+
+// Keys returns a new slice containing the map's keys in insertion order.
+func (m *SyncOrderedMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Keys()
+}
+
+// Iter returns an iterator over the map's key-value pairs in insertion order.
+//
+// Note: Iter returns a snapshot iterator (not live-updated).
+func (m *SyncOrderedMap[K, V]) Iter() iter.Seq2[K, V] {
+	m.mu.RLock()
+	snapshot := m.m.Clone()
+	m.mu.RUnlock()
+
+	return snapshot.Iter()
+}
+
+// Clone creates a new SyncOrderedMap with the same key-value pairs in the same order.
+func (m *SyncOrderedMap[K, V]) Clone() *SyncOrderedMap[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return &SyncOrderedMap[K, V]{
+		m: m.m.Clone(),
+	}
+}
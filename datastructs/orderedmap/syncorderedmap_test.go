@@ -0,0 +1,100 @@
+package orderedmap
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncOrderedMap_NewSync(t *testing.T) {
+	m := NewSync[string, int]()
+
+	if m == nil || m.m == nil {
+		t.Fatal("Expected m and m.m to not be nil")
+	}
+
+	if m.Len() != 0 {
+		t.Errorf("Expected new map to be empty. Got length %d", m.Len())
+	}
+}
+
+func TestSyncOrderedMap_SyncFromOrderedMap(t *testing.T) {
+	src := New[string, int]()
+	src.Set("a", 1)
+
+	dst := SyncFromOrderedMap(src)
+	dst.Set("b", 2)
+
+	if src.Len() != 1 {
+		t.Errorf("Expected source to be unchanged. Got length %d", src.Len())
+	}
+
+	if !slices.Equal(dst.Keys(), []string{"a", "b"}) {
+		t.Errorf("Expected keys %#v. Got %#v", []string{"a", "b"}, dst.Keys())
+	}
+}
+
+func TestSyncOrderedMap_Concurrency(t *testing.T) {
+	const max = 1000
+
+	m := NewSync[int, int]()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < max; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Set(i, i)
+			m.Get(i)
+			m.Keys()
+		}(i)
+	}
+
+	wg.Wait()
+
+	if m.Len() != max {
+		t.Fatalf("Expected length %d. Got %d", max, m.Len())
+	}
+
+	for i := 0; i < max; i += 2 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Delete(i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if m.Len() != max/2 {
+		t.Errorf("Expected length %d. Got %d", max/2, m.Len())
+	}
+
+	for k, v := range m.Iter() {
+		if k%2 == 0 || k != v {
+			t.Errorf("Unexpected pair (%d, %d)", k, v)
+		}
+	}
+}
+
+func TestSyncOrderedMap_Iter(t *testing.T) {
+	m := NewSync[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	seq := m.Iter()
+	m.Set("c", 3)
+	m.Set("a", 10)
+
+	var keys []string
+	var values []int
+	for k, v := range seq {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	if !slices.Equal(keys, []string{"a", "b"}) || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("Expected snapshot to hold %#v => %#v. Got %#v => %#v", []string{"a", "b"}, []int{1, 2}, keys, values)
+	}
+}
@@ -2,6 +2,7 @@
 package queue
 
 import (
+	"encoding/json"
 	"slices"
 
 	"github.com/PsionicAlch/byteforge/internal/datastructs/buffers/ring"
@@ -84,6 +85,40 @@ func (q *Queue[T]) Clone() *Queue[T] {
 	}
 }
 
+// CloneCompact creates a deep copy of the source Queue whose capacity equals
+// its length, with a minimum capacity of 1. This is useful for keeping a queue
+// that grew large and then drained without holding on to unused memory.
+func (q *Queue[T]) CloneCompact() *Queue[T] {
+	return &Queue[T]{
+		buffer: q.buffer.CloneCompact(),
+	}
+}
+
+// Resize sets the capacity of the Queue to exactly newCap. It returns an error
+// if newCap is smaller than Len() or less than 1.
+//
+// The Queue keeps resizing itself on later operations, so Dequeue may still
+// shrink it once usage falls below 25% of the new capacity.
+func (q *Queue[T]) Resize(newCap int) error {
+	return q.buffer.Resize(newCap)
+}
+
+// Reset removes every element from the Queue while keeping its capacity.
+func (q *Queue[T]) Reset() {
+	q.buffer.Reset()
+}
+
+// Trim shrinks the capacity of the Queue down to max(Len(), 8) while
+// preserving the order of its elements. It releases the backing array left
+// behind after a large Queue has been mostly drained. Trim does nothing if the
+// capacity is already at or below that size.
+func (q *Queue[T]) Trim() {
+	newCap := max(q.buffer.Len(), 8)
+	if q.buffer.Cap() > newCap {
+		_ = q.buffer.Resize(newCap)
+	}
+}
+
 // Equals compares the lenght and elements in the Queue to the other Queue.
 func (q *Queue[T]) Equals(other *Queue[T]) bool {
 	s1 := q.ToSlice()
@@ -91,3 +126,61 @@ func (q *Queue[T]) Equals(other *Queue[T]) bool {
 
 	return slices.Equal(s1, s2)
 }
+
+// Drain removes and returns every element of the Queue in FIFO order,
+// leaving the Queue empty with the default capacity.
+func (q *Queue[T]) Drain() []T {
+	items := q.buffer.ToSlice()
+	q.buffer = ring.New[T]()
+
+	return items
+}
+
+// String returns a string representation of the Queue's contents in FIFO
+// order, formatted like Queue[1,2,3].
+func (q *Queue[T]) String() string {
+	return "Queue" + q.buffer.String()
+}
+
+// MarshalJSON encodes the Queue as a JSON array of its elements in FIFO order.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.buffer.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the Queue with the elements of a JSON
+// array, keeping their order. The capacity of the rebuilt Queue equals the
+// number of elements, or the default capacity if the array is empty.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	q.buffer = ring.FromSlice(items)
+
+	return nil
+}
+
+// Map returns a new Queue holding the result of applying f to each element of
+// q, in FIFO order. The source Queue is left unchanged.
+func Map[T comparable, R comparable](q *Queue[T], f func(T) R) *Queue[R] {
+	mapped := make([]R, 0, q.Len())
+	q.buffer.ForEach(func(value T) {
+		mapped = append(mapped, f(value))
+	})
+
+	return FromSlice(mapped)
+}
+
+// Filter returns a new Queue holding only the elements of q for which pred
+// returns true, in FIFO order. The source Queue is left unchanged.
+func Filter[T comparable](q *Queue[T], pred func(T) bool) *Queue[T] {
+	kept := make([]T, 0, q.Len())
+	q.buffer.ForEach(func(value T) {
+		if pred(value) {
+			kept = append(kept, value)
+		}
+	})
+
+	return FromSlice(kept)
+}
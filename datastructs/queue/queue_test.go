@@ -1,7 +1,10 @@
 package queue
 
 import (
+	"encoding/json"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -586,6 +589,123 @@ func TestQueue_Clone(t *testing.T) {
 	}
 }
 
+func TestQueue_CloneCompact(t *testing.T) {
+	src := New[int]()
+	for i := 0; i < 100; i++ {
+		src.Enqueue(i)
+	}
+	for i := 0; i < 90; i++ {
+		_, _ = src.Dequeue()
+	}
+
+	dst := src.CloneCompact()
+
+	if dst.Cap() != dst.Len() {
+		t.Errorf("Expected capacity to equal length %d. Got %d", dst.Len(), dst.Cap())
+	}
+
+	if !slices.Equal(src.ToSlice(), dst.ToSlice()) {
+		t.Errorf("Expected %#v. Got %#v", src.ToSlice(), dst.ToSlice())
+	}
+
+	if empty := New[int](32).CloneCompact(); empty.Cap() != 1 || !empty.IsEmpty() {
+		t.Errorf("Expected empty clone with capacity 1. Got length %d and capacity %d", empty.Len(), empty.Cap())
+	}
+}
+
+func TestQueue_Resize(t *testing.T) {
+	q := FromSlice([]int{1, 2, 3}, 16)
+
+	if err := q.Resize(3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if q.Cap() != 3 || !slices.Equal(q.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected %#v with capacity 3. Got %#v with capacity %d", []int{1, 2, 3}, q.ToSlice(), q.Cap())
+	}
+
+	if err := q.Resize(2); err == nil {
+		t.Error("Expected an error when resizing below the current length")
+	}
+
+	if q.Cap() != 3 {
+		t.Errorf("Expected capacity to stay 3. Got %d", q.Cap())
+	}
+}
+
+func TestQueue_Reset(t *testing.T) {
+	q := FromSlice([]int{1, 2, 3}, 16)
+	q.Reset()
+
+	if !q.IsEmpty() || q.Cap() != 16 {
+		t.Errorf("Expected empty queue with capacity 16. Got %#v with capacity %d", q.ToSlice(), q.Cap())
+	}
+
+	q.Enqueue(4)
+	if !slices.Equal(q.ToSlice(), []int{4}) {
+		t.Errorf("Expected %#v. Got %#v", []int{4}, q.ToSlice())
+	}
+}
+
+func TestQueue_Trim(t *testing.T) {
+	t.Run("Trim after grow and drain", func(t *testing.T) {
+		q := New[int]()
+		for i := 0; i < 1000; i++ {
+			q.Enqueue(i)
+		}
+
+		for i := 0; i < 900; i++ {
+			q.Dequeue()
+		}
+
+		if q.Cap() <= 100 {
+			t.Fatalf("Expected capacity to be larger than 100 before Trim. Got %d", q.Cap())
+		}
+
+		q.Trim()
+
+		if q.Cap() != 100 {
+			t.Errorf("Expected capacity to be 100. Got %d", q.Cap())
+		}
+
+		expected := make([]int, 100)
+		for i := range expected {
+			expected[i] = 900 + i
+		}
+
+		if !slices.Equal(q.ToSlice(), expected) {
+			t.Errorf("Expected %#v. Got %#v", expected, q.ToSlice())
+		}
+	})
+
+	t.Run("Trim keeps a minimum capacity of 8", func(t *testing.T) {
+		q := FromSlice([]int{1, 2, 3}, 64)
+		q.Trim()
+
+		if q.Cap() != 8 || !slices.Equal(q.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected %#v with capacity 8. Got %#v with capacity %d", []int{1, 2, 3}, q.ToSlice(), q.Cap())
+		}
+
+		q.Enqueue(4)
+		if !slices.Equal(q.ToSlice(), []int{1, 2, 3, 4}) {
+			t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3, 4}, q.ToSlice())
+		}
+	})
+
+	t.Run("Trim does not grow a small Queue", func(t *testing.T) {
+		q := FromSlice([]int{1, 2, 3}, 16)
+		if err := q.Resize(3); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		q.Trim()
+
+		if q.Cap() != 3 {
+			t.Errorf("Expected capacity to stay 3. Got %d", q.Cap())
+		}
+	})
+}
+
 func TestQueue_Equals(t *testing.T) {
 	q1 := FromSlice([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
 	q2 := q1.Clone()
@@ -608,3 +728,250 @@ func makeRange(start, end int) []int {
 
 	return out
 }
+
+func TestQueue_Drain(t *testing.T) {
+	scenarios := []struct {
+		name  string
+		input []int
+	}{
+		{"Empty queue", []int{}},
+		{"Single element", []int{1}},
+		{"Multiple elements", []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			q := FromSlice(scenario.input)
+			items := q.Drain()
+
+			if !slices.Equal(items, scenario.input) {
+				t.Errorf("Expected %#v. Got %#v", scenario.input, items)
+			}
+
+			if !q.IsEmpty() {
+				t.Errorf("Expected queue to be empty. Got %#v", q.ToSlice())
+			}
+
+			q.Enqueue(10, 11)
+			if !slices.Equal(q.ToSlice(), []int{10, 11}) {
+				t.Errorf("Expected %#v. Got %#v", []int{10, 11}, q.ToSlice())
+			}
+		})
+	}
+}
+
+func TestQueue_String(t *testing.T) {
+	q := New[int](4)
+	q.Enqueue(1, 2, 3, 4)
+	_, _ = q.Dequeue()
+	q.Enqueue(5)
+
+	if q.String() != "Queue[2,3,4,5]" {
+		t.Errorf("Expected %q. Got %q", "Queue[2,3,4,5]", q.String())
+	}
+
+	if New[int]().String() != "Queue[]" {
+		t.Errorf("Expected %q. Got %q", "Queue[]", New[int]().String())
+	}
+
+	type point struct{ X, Y int }
+
+	// Element formatting is left to fmt, so only check the overall shape.
+	s := FromSlice([]point{{1, 2}, {3, 4}}).String()
+	if !strings.HasPrefix(s, "Queue[") || !strings.HasSuffix(s, "]") || !strings.Contains(s, "1") || !strings.Contains(s, "4") {
+		t.Errorf("Unexpected string %q", s)
+	}
+}
+
+func TestQueue_JSON(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		q := New[int](4)
+		q.Enqueue(1, 2, 3, 4)
+		_, _ = q.Dequeue()
+		q.Enqueue(5)
+
+		data, err := json.Marshal(q)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if string(data) != "[2,3,4,5]" {
+			t.Errorf("Expected %s. Got %s", "[2,3,4,5]", data)
+		}
+
+		var decoded Queue[int]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !decoded.Equals(q) {
+			t.Errorf("Expected %#v. Got %#v", q.ToSlice(), decoded.ToSlice())
+		}
+
+		if decoded.Cap() != decoded.Len() {
+			t.Errorf("Expected capacity to equal length %d. Got %d", decoded.Len(), decoded.Cap())
+		}
+
+		// FIFO order must survive the round trip.
+		if v, _ := decoded.Dequeue(); v != 2 {
+			t.Errorf("Expected first element to be 2. Got %d", v)
+		}
+	})
+
+	t.Run("Decoded queue can be modified", func(t *testing.T) {
+		var decoded Queue[int]
+		if err := json.Unmarshal([]byte("[1,2,3,4,5]"), &decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		decoded.Dequeue()
+		decoded.Enqueue(6)
+
+		if !slices.Equal(decoded.ToSlice(), []int{2, 3, 4, 5, 6}) {
+			t.Errorf("Expected %#v. Got %#v", []int{2, 3, 4, 5, 6}, decoded.ToSlice())
+		}
+	})
+
+	t.Run("As a struct field", func(t *testing.T) {
+		type job struct {
+			Tasks *Queue[string] `json:"tasks"`
+		}
+
+		data, err := json.Marshal(job{Tasks: FromSlice([]string{"a", "b"})})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if string(data) != `{"tasks":["a","b"]}` {
+			t.Errorf("Expected %s. Got %s", `{"tasks":["a","b"]}`, data)
+		}
+
+		var decoded job
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !slices.Equal(decoded.Tasks.ToSlice(), []string{"a", "b"}) {
+			t.Errorf("Expected %#v. Got %#v", []string{"a", "b"}, decoded.Tasks.ToSlice())
+		}
+	})
+
+	t.Run("Empty queue", func(t *testing.T) {
+		data, err := json.Marshal(New[int]())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if string(data) != "[]" {
+			t.Errorf("Expected %s. Got %s", "[]", data)
+		}
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		q := FromSlice([]int{1, 2})
+
+		if err := json.Unmarshal([]byte(`["a"]`), q); err == nil {
+			t.Error("Expected an error when decoding mismatched element types")
+		}
+
+		if !slices.Equal(q.ToSlice(), []int{1, 2}) {
+			t.Errorf("Expected queue to be unchanged after a failed decode. Got %#v", q.ToSlice())
+		}
+	})
+}
+
+func TestQueue_Map(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected []string
+	}{
+		{"Empty queue", []int{}, []string{}},
+		{"Single element", []int{1}, []string{"1"}},
+		{"Multiple elements", []int{1, 2, 3}, []string{"1", "2", "3"}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			q := FromSlice(scenario.input)
+			mapped := Map(q, func(n int) string { return strconv.Itoa(n) })
+
+			if !slices.Equal(mapped.ToSlice(), scenario.expected) {
+				t.Errorf("Expected %#v. Got %#v", scenario.expected, mapped.ToSlice())
+			}
+
+			if !slices.Equal(q.ToSlice(), scenario.input) {
+				t.Errorf("Expected source queue to be unchanged. Got %#v", q.ToSlice())
+			}
+		})
+	}
+
+	t.Run("Wrapped buffer", func(t *testing.T) {
+		q := New[int](4)
+		q.Enqueue(1, 2, 3, 4)
+		q.Dequeue()
+		q.Dequeue()
+		q.Enqueue(5, 6)
+
+		mapped := Map(q, func(n int) int { return n * 10 })
+		expected := []int{30, 40, 50, 60}
+
+		if !slices.Equal(mapped.ToSlice(), expected) {
+			t.Errorf("Expected %#v. Got %#v", expected, mapped.ToSlice())
+		}
+	})
+
+	t.Run("Returned queue can be modified", func(t *testing.T) {
+		mapped := Map(FromSlice([]int{1, 2, 3, 4, 5}), func(n int) int { return n * 10 })
+
+		mapped.Dequeue()
+		mapped.Enqueue(60)
+
+		expected := []int{20, 30, 40, 50, 60}
+		if !slices.Equal(mapped.ToSlice(), expected) {
+			t.Errorf("Expected %#v. Got %#v", expected, mapped.ToSlice())
+		}
+	})
+}
+
+func TestQueue_Filter(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Empty queue", []int{}, []int{}},
+		{"No matches", []int{1, 3, 5}, []int{}},
+		{"Some matches", []int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}},
+		{"All match", []int{2, 4}, []int{2, 4}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			q := FromSlice(scenario.input)
+			filtered := Filter(q, isEven)
+
+			if !slices.Equal(filtered.ToSlice(), scenario.expected) {
+				t.Errorf("Expected %#v. Got %#v", scenario.expected, filtered.ToSlice())
+			}
+
+			if !slices.Equal(q.ToSlice(), scenario.input) {
+				t.Errorf("Expected source queue to be unchanged. Got %#v", q.ToSlice())
+			}
+		})
+	}
+
+	t.Run("Returned queue can be modified", func(t *testing.T) {
+		filtered := Filter(FromSlice([]int{2, 4, 6, 8, 10, 11}), isEven)
+
+		filtered.Dequeue()
+		filtered.Enqueue(12)
+
+		expected := []int{4, 6, 8, 10, 12}
+		if !slices.Equal(filtered.ToSlice(), expected) {
+			t.Errorf("Expected %#v. Got %#v", expected, filtered.ToSlice())
+		}
+	})
+}
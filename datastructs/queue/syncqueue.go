@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"encoding/json"
 	"slices"
 	"sync"
 
@@ -110,6 +111,51 @@ func (q *SyncQueue[T]) Clone() *SyncQueue[T] {
 	}
 }
 
+// CloneCompact creates a deep copy of the source SyncQueue whose capacity equals
+// its length, with a minimum capacity of 1. This is useful for keeping a queue
+// that grew large and then drained without holding on to unused memory.
+func (q *SyncQueue[T]) CloneCompact() *SyncQueue[T] {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return &SyncQueue[T]{
+		buffer: q.buffer.CloneCompact(),
+	}
+}
+
+// Resize sets the capacity of the SyncQueue to exactly newCap. It returns an
+// error if newCap is smaller than Len() or less than 1.
+//
+// The SyncQueue keeps resizing itself on later operations, so Dequeue may
+// still shrink it once usage falls below 25% of the new capacity.
+func (q *SyncQueue[T]) Resize(newCap int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.buffer.Resize(newCap)
+}
+
+// Reset removes every element from the SyncQueue while keeping its capacity.
+func (q *SyncQueue[T]) Reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.buffer.Reset()
+}
+
+// Trim shrinks the capacity of the SyncQueue down to max(Len(), 8) while
+// preserving the order of its elements. Trim does nothing if the capacity is
+// already at or below that size.
+func (q *SyncQueue[T]) Trim() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	newCap := max(q.buffer.Len(), 8)
+	if q.buffer.Cap() > newCap {
+		_ = q.buffer.Resize(newCap)
+	}
+}
+
 // Equals compares the lenght and elements in the Queue to the other Queue.
 func (q *SyncQueue[T]) Equals(other *SyncQueue[T]) bool {
 	q1, q2 := utils.SortByAddress(q, other)
@@ -122,3 +168,99 @@ func (q *SyncQueue[T]) Equals(other *SyncQueue[T]) bool {
 
 	return slices.Equal(q1.buffer.ToSlice(), q2.buffer.ToSlice())
 }
+
+// TransferAll moves every element from q into dst, preserving FIFO order, and
+// returns the number of elements moved. Both queues are locked for the duration
+// of the transfer so it is atomic with respect to other queue operations.
+func (q *SyncQueue[T]) TransferAll(dst *SyncQueue[T]) int {
+	if q == dst {
+		return 0
+	}
+
+	// Lock both in address order to avoid deadlock
+	first, second := utils.SortByAddress(q, dst)
+
+	first.mu.Lock()
+	defer first.mu.Unlock()
+
+	second.mu.Lock()
+	defer second.mu.Unlock()
+
+	items := q.buffer.ToSlice()
+	dst.buffer.Enqueue(items...)
+	q.buffer = ring.New[T]()
+
+	return len(items)
+}
+
+// RemoveFunc removes every element for which pred returns true and returns the
+// number of elements removed. The remaining elements keep their FIFO order and
+// the queue keeps its capacity. The whole operation runs under a single write
+// lock so it is atomic with respect to other queue operations.
+func (q *SyncQueue[T]) RemoveFunc(pred func(T) bool) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	items := q.buffer.ToSlice()
+	kept := slices.DeleteFunc(items, pred)
+	removed := q.buffer.Len() - len(kept)
+
+	// Rebuild through New rather than FromSlice, which ignores small capacity hints.
+	if removed > 0 {
+		buffer := ring.New[T](q.buffer.Cap())
+		buffer.Enqueue(kept...)
+		q.buffer = buffer
+	}
+
+	return removed
+}
+
+// Drain removes and returns every element of the SyncQueue in FIFO order,
+// leaving it empty with the default capacity. The whole operation runs under a
+// single write lock so it is atomic with respect to other queue operations.
+func (q *SyncQueue[T]) Drain() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	items := q.buffer.ToSlice()
+	q.buffer = ring.New[T]()
+
+	return items
+}
+
+// String returns a string representation of the SyncQueue's contents in FIFO
+// order, formatted like SyncQueue[1,2,3].
+func (q *SyncQueue[T]) String() string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return "SyncQueue" + q.buffer.String()
+}
+
+// MarshalJSON encodes the SyncQueue as a JSON array of its elements in FIFO order.
+func (q *SyncQueue[T]) MarshalJSON() ([]byte, error) {
+	q.mu.RLock()
+	items := q.buffer.ToSlice()
+	q.mu.RUnlock()
+
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON replaces the contents of the SyncQueue with the elements of a
+// JSON array, keeping their order. The capacity of the rebuilt SyncQueue equals
+// the number of elements, or the default capacity if the array is empty.
+func (q *SyncQueue[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	buffer := ring.FromSlice(items)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.buffer = buffer
+
+	return nil
+}
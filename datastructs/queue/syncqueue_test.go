@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"encoding/json"
 	"slices"
 	"sync"
 	"testing"
@@ -372,6 +373,38 @@ func TestSyncQueue_Clone(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSyncQueue_CloneCompact(t *testing.T) {
+	src := NewSync[int]()
+	for i := 0; i < 100; i++ {
+		src.Enqueue(i)
+	}
+	for i := 0; i < 90; i++ {
+		_, _ = src.Dequeue()
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			dst := src.CloneCompact()
+
+			if dst.Cap() != dst.Len() {
+				t.Errorf("Expected capacity to equal length %d. Got %d", dst.Len(), dst.Cap())
+			}
+
+			if !slices.Equal(src.ToSlice(), dst.ToSlice()) {
+				t.Errorf("Expected %#v. Got %#v", src.ToSlice(), dst.ToSlice())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
 func TestSyncQueue_Equal(t *testing.T) {
 	q1 := SyncFromSlice([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
 	q2 := q1.Clone()
@@ -397,3 +430,431 @@ func TestSyncQueue_Equal(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestSyncQueue_ResizeAndReset(t *testing.T) {
+	q := NewSync[int](16)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			q.Enqueue(i)
+			if err := q.Resize(256); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			q.Reset()
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_, _ = q.Peek()
+			_ = q.ToSlice()
+		}()
+	}
+
+	wg.Wait()
+
+	q.Reset()
+	if !q.IsEmpty() || q.Cap() != 256 {
+		t.Errorf("Expected empty queue with capacity 256. Got %#v with capacity %d", q.ToSlice(), q.Cap())
+	}
+
+	q.Enqueue(1, 2, 3)
+	if err := q.Resize(2); err == nil {
+		t.Error("Expected an error when resizing below the current length")
+	}
+
+	if !slices.Equal(q.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3}, q.ToSlice())
+	}
+}
+
+func TestSyncQueue_Trim(t *testing.T) {
+	q := NewSync[int]()
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(i)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			q.Dequeue()
+			q.Trim()
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_ = q.Cap()
+			_, _ = q.Peek()
+		}()
+	}
+
+	wg.Wait()
+
+	q.Trim()
+
+	if q.Len() != 900 || q.Cap() != 900 {
+		t.Errorf("Expected length and capacity to be 900. Got %d and %d", q.Len(), q.Cap())
+	}
+
+	if first, _ := q.Peek(); first != 100 {
+		t.Errorf("Expected front of the queue to be 100. Got %d", first)
+	}
+}
+
+func TestSyncQueue_TransferAll(t *testing.T) {
+	t.Run("Transfer preserves order", func(t *testing.T) {
+		src := SyncFromSlice([]int{1, 2, 3})
+		dst := SyncFromSlice([]int{0})
+
+		moved := src.TransferAll(dst)
+
+		if moved != 3 {
+			t.Errorf("Expected 3 elements to be moved. Got %d", moved)
+		}
+
+		if !src.IsEmpty() {
+			t.Errorf("Expected src to be empty. Got %#v", src.ToSlice())
+		}
+
+		if !slices.Equal(dst.ToSlice(), []int{0, 1, 2, 3}) {
+			t.Errorf("Expected dst to be %#v. Got %#v", []int{0, 1, 2, 3}, dst.ToSlice())
+		}
+	})
+
+	t.Run("Transfer to self", func(t *testing.T) {
+		q := SyncFromSlice([]int{1, 2, 3})
+
+		if moved := q.TransferAll(q); moved != 0 {
+			t.Errorf("Expected 0 elements to be moved. Got %d", moved)
+		}
+
+		if !slices.Equal(q.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected q to be unchanged. Got %#v", q.ToSlice())
+		}
+	})
+
+	t.Run("Concurrent transfer and enqueue", func(t *testing.T) {
+		const producers = 10
+		const perProducer = 1000
+
+		src := NewSync[int]()
+		dst := NewSync[int]()
+
+		var wg sync.WaitGroup
+		var moved int
+		stop := make(chan struct{})
+		finished := make(chan struct{})
+
+		go func() {
+			defer close(finished)
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					moved += src.TransferAll(dst)
+				}
+			}
+		}()
+
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for i := 0; i < perProducer; i++ {
+					src.Enqueue(p*perProducer + i)
+				}
+			}(p)
+		}
+
+		wg.Wait()
+		close(stop)
+		<-finished
+
+		moved += src.TransferAll(dst)
+
+		if moved != producers*perProducer {
+			t.Errorf("Expected %d elements to be moved. Got %d", producers*perProducer, moved)
+		}
+
+		seen := make(map[int]int)
+		for _, item := range dst.ToSlice() {
+			seen[item]++
+		}
+
+		for i := 0; i < producers*perProducer; i++ {
+			if seen[i] != 1 {
+				t.Errorf("Expected %d to appear exactly once in dst. Got %d", i, seen[i])
+			}
+		}
+	})
+}
+
+func TestSyncQueue_Drain(t *testing.T) {
+	t.Run("Drain preserves order", func(t *testing.T) {
+		q := SyncFromSlice([]int{1, 2, 3})
+		items := q.Drain()
+
+		if !slices.Equal(items, []int{1, 2, 3}) {
+			t.Errorf("Expected %#v. Got %#v", []int{1, 2, 3}, items)
+		}
+
+		if !q.IsEmpty() {
+			t.Errorf("Expected q to be empty. Got %#v", q.ToSlice())
+		}
+
+		if items := q.Drain(); len(items) != 0 {
+			t.Errorf("Expected draining an empty queue to return nothing. Got %#v", items)
+		}
+	})
+
+	t.Run("Concurrent drain and enqueue", func(t *testing.T) {
+		const producers = 10
+		const perProducer = 1000
+
+		q := NewSync[int]()
+
+		var wg sync.WaitGroup
+		var drained []int
+		stop := make(chan struct{})
+		finished := make(chan struct{})
+
+		go func() {
+			defer close(finished)
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					drained = append(drained, q.Drain()...)
+				}
+			}
+		}()
+
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for i := 0; i < perProducer; i++ {
+					q.Enqueue(p*perProducer + i)
+				}
+			}(p)
+		}
+
+		wg.Wait()
+		close(stop)
+		<-finished
+
+		leftover := q.ToSlice()
+
+		seen := make(map[int]int)
+		for _, item := range drained {
+			seen[item]++
+		}
+		for _, item := range leftover {
+			seen[item]++
+		}
+
+		for i := 0; i < producers*perProducer; i++ {
+			if seen[i] != 1 {
+				t.Errorf("Expected %d to be either drained or left behind exactly once. Got %d", i, seen[i])
+			}
+		}
+	})
+}
+
+func TestSyncQueue_RemoveFunc(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("Remove preserves order", func(t *testing.T) {
+		q := SyncFromSlice([]int{1, 2, 3, 4, 5, 6}, 16)
+
+		removed := q.RemoveFunc(isEven)
+
+		if removed != 3 {
+			t.Errorf("Expected 3 elements to be removed. Got %d", removed)
+		}
+
+		if !slices.Equal(q.ToSlice(), []int{1, 3, 5}) {
+			t.Errorf("Expected q to be %#v. Got %#v", []int{1, 3, 5}, q.ToSlice())
+		}
+
+		if q.Cap() != 16 {
+			t.Errorf("Expected capacity to stay 16. Got %d", q.Cap())
+		}
+	})
+
+	t.Run("Remove keeps a small capacity", func(t *testing.T) {
+		q := SyncFromSlice([]int{1, 2, 3, 4, 5, 6})
+
+		if removed := q.RemoveFunc(isEven); removed != 3 {
+			t.Errorf("Expected 3 elements to be removed. Got %d", removed)
+		}
+
+		if q.Cap() != 6 {
+			t.Errorf("Expected capacity to stay 6. Got %d", q.Cap())
+		}
+
+		q.Dequeue()
+		q.Enqueue(7, 9)
+		if !slices.Equal(q.ToSlice(), []int{3, 5, 7, 9}) {
+			t.Errorf("Expected q to be %#v. Got %#v", []int{3, 5, 7, 9}, q.ToSlice())
+		}
+	})
+
+	t.Run("Nothing to remove", func(t *testing.T) {
+		q := SyncFromSlice([]int{1, 3, 5})
+
+		if removed := q.RemoveFunc(isEven); removed != 0 {
+			t.Errorf("Expected 0 elements to be removed. Got %d", removed)
+		}
+
+		if !slices.Equal(q.ToSlice(), []int{1, 3, 5}) {
+			t.Errorf("Expected q to be unchanged. Got %#v", q.ToSlice())
+		}
+	})
+
+	t.Run("Remove everything", func(t *testing.T) {
+		q := SyncFromSlice([]int{2, 4})
+
+		if removed := q.RemoveFunc(isEven); removed != 2 {
+			t.Errorf("Expected 2 elements to be removed. Got %d", removed)
+		}
+
+		if !q.IsEmpty() {
+			t.Errorf("Expected q to be empty. Got %#v", q.ToSlice())
+		}
+
+		q.Enqueue(7)
+		if !slices.Equal(q.ToSlice(), []int{7}) {
+			t.Errorf("Expected q to be usable after being emptied. Got %#v", q.ToSlice())
+		}
+	})
+
+	t.Run("Concurrent remove and enqueue", func(t *testing.T) {
+		const max = 10000
+
+		q := NewSync[int]()
+
+		var removed int
+		stop := make(chan struct{})
+		finished := make(chan struct{})
+
+		go func() {
+			defer close(finished)
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					removed += q.RemoveFunc(isEven)
+				}
+			}
+		}()
+
+		for i := 0; i < max; i++ {
+			q.Enqueue(i)
+		}
+
+		close(stop)
+		<-finished
+
+		removed += q.RemoveFunc(isEven)
+
+		if removed != max/2 {
+			t.Errorf("Expected %d elements to be removed. Got %d", max/2, removed)
+		}
+
+		items := q.ToSlice()
+		if len(items) != max/2 {
+			t.Fatalf("Expected %d elements to remain. Got %d", max/2, len(items))
+		}
+
+		// The odd numbers must remain in the order they were enqueued.
+		for i, item := range items {
+			if item != 2*i+1 {
+				t.Fatalf("Expected element %d to be %d. Got %d", i, 2*i+1, item)
+			}
+		}
+	})
+}
+
+func TestSyncQueue_String(t *testing.T) {
+	q := SyncFromSlice([]int{1, 2, 3})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if q.String() != "SyncQueue[1,2,3]" {
+				t.Errorf("Expected %q. Got %q", "SyncQueue[1,2,3]", q.String())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncQueue_JSON(t *testing.T) {
+	q := SyncFromSlice([]int{1, 2, 3})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			data, err := json.Marshal(q)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			decoded := NewSync[int]()
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if !decoded.Equals(q) {
+				t.Errorf("Expected %#v. Got %#v", q.ToSlice(), decoded.ToSlice())
+			}
+
+			if decoded.Cap() != decoded.Len() {
+				t.Errorf("Expected capacity to equal length %d. Got %d", decoded.Len(), decoded.Cap())
+			}
+
+			decoded.Dequeue()
+			decoded.Enqueue(4)
+
+			if !slices.Equal(decoded.ToSlice(), []int{2, 3, 4}) {
+				t.Errorf("Expected %#v. Got %#v", []int{2, 3, 4}, decoded.ToSlice())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -1,6 +1,13 @@
 package set
 
-import "iter"
+import (
+	"bytes"
+	"encoding/gob"
+	"iter"
+	"slices"
+
+	"github.com/PsionicAlch/byteforge/constraints"
+)
 
 // Set implements a generic set data structure
 type Set[T comparable] struct {
@@ -40,6 +47,14 @@ func FromSyncSet[T comparable](set *SyncSet[T]) *Set[T] {
 	return clone.set
 }
 
+// FromIter creates a new Set from every element yielded by the sequence
+func FromIter[T comparable](seq iter.Seq[T]) *Set[T] {
+	s := New[T]()
+	s.AddFromIter(seq)
+
+	return s
+}
+
 // Contains checks if the Set contains the specified item
 func (s *Set[T]) Contains(item T) bool {
 	_, has := s.items[item]
@@ -54,6 +69,24 @@ func (s *Set[T]) Push(items ...T) {
 	}
 }
 
+// Add inserts a single item into the Set and returns true if the item was
+// not already present
+func (s *Set[T]) Add(item T) bool {
+	if s.Contains(item) {
+		return false
+	}
+
+	s.items[item] = struct{}{}
+	return true
+}
+
+// AddFromIter inserts every element yielded by the sequence into the Set
+func (s *Set[T]) AddFromIter(seq iter.Seq[T]) {
+	for item := range seq {
+		s.items[item] = struct{}{}
+	}
+}
+
 // Pop removes and returns an arbitrary element from the Set
 //
 // Note: The selection of which element to pop is non-deterministic due to Go's map iteration order
@@ -67,6 +100,22 @@ func (s *Set[T]) Pop() (T, bool) {
 	return zero, false
 }
 
+// PopFunc removes and returns an arbitrary element for which pred returns true.
+// If no element matches, the zero value of T and false are returned
+//
+// Note: When several elements match, which one is popped is non-deterministic due to Go's map iteration order
+func (s *Set[T]) PopFunc(pred func(T) bool) (T, bool) {
+	for item := range s.items {
+		if pred(item) {
+			delete(s.items, item)
+			return item, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
 // Peek returns an arbitrary element from the Set without removing it
 //
 // Note: The selection of which element to peek is non-deterministic due to Go's map iteration order
@@ -115,6 +164,22 @@ func (s *Set[T]) Clear() {
 	s.items = make(map[T]struct{})
 }
 
+// Grow ensures the Set has room for at least n more elements without
+// rehashing during later inserts. Go maps do not expose their capacity, so the
+// underlying map is rebuilt with a size hint of Size()+n whenever n > 0
+func (s *Set[T]) Grow(n int) {
+	if n <= 0 {
+		return
+	}
+
+	items := make(map[T]struct{}, len(s.items)+n)
+	for item := range s.items {
+		items[item] = struct{}{}
+	}
+
+	s.items = items
+}
+
 // Clone creates a new Set with the same elements
 func (s *Set[T]) Clone() *Set[T] {
 	clone := &Set[T]{items: make(map[T]struct{}, len(s.items))}
@@ -134,6 +199,14 @@ func (s *Set[T]) Union(other *Set[T]) *Set[T] {
 	return result
 }
 
+// Merge adds all elements of other into s in place, avoiding the allocation
+// of a new Set that Union requires
+func (s *Set[T]) Merge(other *Set[T]) {
+	for item := range other.items {
+		s.items[item] = struct{}{}
+	}
+}
+
 // Intersection returns a new Set containing elements present in both Sets
 func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
 	result := New[T]()
@@ -185,6 +258,110 @@ func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
 	return result
 }
 
+// DifferenceUpdate removes every element of other from s in place, avoiding
+// the allocation of a new Set that Difference requires
+func (s *Set[T]) DifferenceUpdate(other *Set[T]) {
+	if s == other {
+		s.Clear()
+		return
+	}
+
+	for item := range other.items {
+		delete(s.items, item)
+	}
+}
+
+// IntersectionUpdate removes every element of s that isn't in other in place,
+// avoiding the allocation of a new Set that Intersection requires
+func (s *Set[T]) IntersectionUpdate(other *Set[T]) {
+	for item := range s.items {
+		if !other.Contains(item) {
+			delete(s.items, item)
+		}
+	}
+}
+
+// SymmetricDifferenceUpdate updates s in place to hold the elements in either
+// Set but not in both, avoiding the allocation of a new Set that
+// SymmetricDifference requires
+func (s *Set[T]) SymmetricDifferenceUpdate(other *Set[T]) {
+	if s == other {
+		s.Clear()
+		return
+	}
+
+	for item := range other.items {
+		if s.Contains(item) {
+			delete(s.items, item)
+		} else {
+			s.items[item] = struct{}{}
+		}
+	}
+}
+
+// FilterCount returns a new Set holding the elements for which pred returns
+// true, along with the number of elements that didn't match
+func (s *Set[T]) FilterCount(pred func(T) bool) (*Set[T], int) {
+	result := New[T]()
+	removed := 0
+
+	for item := range s.items {
+		if pred(item) {
+			result.items[item] = struct{}{}
+		} else {
+			removed++
+		}
+	}
+
+	return result, removed
+}
+
+// IntersectionSize returns the number of elements present in both Sets
+// without allocating a result Set
+func (s *Set[T]) IntersectionSize(other *Set[T]) int {
+	// Determine which set is smaller to optimize iteration
+	if s.Size() > other.Size() {
+		s, other = other, s
+	}
+
+	count := 0
+	for item := range s.items {
+		if other.Contains(item) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// UnionSize returns the number of elements present in either Set
+// without allocating a result Set
+func (s *Set[T]) UnionSize(other *Set[T]) int {
+	return s.Size() + other.Size() - s.IntersectionSize(other)
+}
+
+// DifferenceSize returns the number of elements in s that are not in other
+// without allocating a result Set
+func (s *Set[T]) DifferenceSize(other *Set[T]) int {
+	return s.Size() - s.IntersectionSize(other)
+}
+
+// Intersects returns true if s and other have at least one element in common
+func (s *Set[T]) Intersects(other *Set[T]) bool {
+	// Determine which set is smaller to optimize iteration
+	if s.Size() > other.Size() {
+		s, other = other, s
+	}
+
+	for item := range s.items {
+		if other.Contains(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IsSubsetOf returns true if all elements in s are also in other
 func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
 	for item := range s.items {
@@ -223,3 +400,71 @@ func (s *Set[T]) ToSlice() []T {
 
 	return items
 }
+
+// GobEncode implements the gob.GobEncoder interface by encoding the elements
+// of the Set as a slice
+func (s *Set[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(s.ToSlice()); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface by replacing the contents
+// of the Set with the decoded elements
+//
+// The Set is left unchanged if decoding fails
+func (s *Set[T]) GobDecode(data []byte) error {
+	var items []T
+
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+
+	s.items = FromSlice(items).items
+
+	return nil
+}
+
+// Jaccard returns the Jaccard similarity of two Sets, defined as the size of
+// their intersection divided by the size of their union
+//
+// Two empty Sets are considered identical and have a similarity of 1.0
+func Jaccard[T comparable](a, b *Set[T]) float64 {
+	union := a.UnionSize(b)
+	if union == 0 {
+		return 1.0
+	}
+
+	return float64(a.IntersectionSize(b)) / float64(union)
+}
+
+// MapToSlice applies f to every element of the Set and returns the results as a
+// slice. Unlike mapping into another Set, the result type doesn't need to be
+// comparable
+//
+// The order of the returned slice is arbitrary, just like iterating over the Set
+func MapToSlice[T comparable, R any](s *Set[T], f func(T) R) []R {
+	result := make([]R, 0, len(s.items))
+
+	for item := range s.items {
+		result = append(result, f(item))
+	}
+
+	return result
+}
+
+// EachSorted calls f for every element of the Set in ascending order, along
+// with the element's position in that order. Unlike iterating over the Set,
+// the traversal is deterministic
+func EachSorted[T constraints.Ordered](s *Set[T], f func(int, T)) {
+	items := s.ToSlice()
+	slices.Sort(items)
+
+	for i, item := range items {
+		f(i, item)
+	}
+}
@@ -1,6 +1,11 @@
 package set
 
 import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -125,6 +130,34 @@ func TestSet_Push(t *testing.T) {
 	}
 }
 
+func TestSet_Add(t *testing.T) {
+	s := New[int]()
+
+	if !s.Add(1) {
+		t.Error("Add(1) on empty set should return true")
+	}
+
+	if !s.Contains(1) || s.Size() != 1 {
+		t.Errorf("Add(1) failed. Set: %v", s.ToSlice())
+	}
+
+	if s.Add(1) {
+		t.Error("Add(1) for existing item should return false")
+	}
+
+	if s.Size() != 1 {
+		t.Errorf("Adding existing item changed size. Set: %v, Size: %d", s.ToSlice(), s.Size())
+	}
+
+	if !s.Add(2) {
+		t.Error("Add(2) should return true")
+	}
+
+	if !s.Contains(2) || s.Size() != 2 {
+		t.Errorf("Add(2) failed. Set: %v", s.ToSlice())
+	}
+}
+
 func TestSet_Pop(t *testing.T) {
 	t.Run("Pop from non-empty set", func(t *testing.T) {
 		s := FromSlice([]int{10, 20, 30})
@@ -162,6 +195,74 @@ func TestSet_Pop(t *testing.T) {
 	})
 }
 
+func TestSet_PopFunc(t *testing.T) {
+	s := FromSlice([]int{1, 2, 3, 4})
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	popped := New[int]()
+	for i := 0; i < 2; i++ {
+		item, found := s.PopFunc(isEven)
+		if !found {
+			t.Fatal("Expected to pop an even element")
+		}
+
+		if !isEven(item) {
+			t.Errorf("Expected popped element to be even. Got %d", item)
+		}
+
+		popped.Push(item)
+	}
+
+	if !popped.Equals(FromSlice([]int{2, 4})) {
+		t.Errorf("Expected to pop 2 and 4. Got %v", popped.ToSlice())
+	}
+
+	if item, found := s.PopFunc(isEven); found || item != 0 {
+		t.Errorf("Expected (0, false) when nothing matches. Got (%d, %v)", item, found)
+	}
+
+	if !s.Equals(FromSlice([]int{1, 3})) {
+		t.Errorf("Expected remaining elements to be %v. Got %v", []int{1, 3}, s.ToSlice())
+	}
+}
+
+func TestSet_FromIter(t *testing.T) {
+	// evens yields only the even numbers of the input, including duplicates.
+	evens := func(yield func(int) bool) {
+		for _, n := range []int{1, 2, 2, 3, 4, 4, 4, 5, 6} {
+			if n%2 == 0 && !yield(n) {
+				return
+			}
+		}
+	}
+
+	s := FromIter(evens)
+
+	if !s.Equals(FromSlice([]int{2, 4, 6})) {
+		t.Errorf("FromIter() = %v, want %v", s.ToSlice(), []int{2, 4, 6})
+	}
+
+	// Build a set from another set's iterator.
+	clone := FromIter(s.Iter())
+	if !clone.Equals(s) {
+		t.Errorf("FromIter(s.Iter()) = %v, want %v", clone.ToSlice(), s.ToSlice())
+	}
+
+	if empty := FromIter(slices.Values([]int{})); !empty.IsEmpty() {
+		t.Errorf("Expected empty set. Got %v", empty.ToSlice())
+	}
+}
+
+func TestSet_AddFromIter(t *testing.T) {
+	s := FromSlice([]int{1, 2})
+
+	s.AddFromIter(slices.Values([]int{2, 3, 3, 4}))
+
+	if !s.Equals(FromSlice([]int{1, 2, 3, 4})) {
+		t.Errorf("AddFromIter() = %v, want %v", s.ToSlice(), []int{1, 2, 3, 4})
+	}
+}
+
 func TestSet_Peek(t *testing.T) {
 	t.Run("Peek from non-empty set", func(t *testing.T) {
 		s := FromSlice([]int{10, 20, 30})
@@ -353,6 +454,37 @@ func TestSet_Clear(t *testing.T) {
 	}
 }
 
+func TestSet_Grow(t *testing.T) {
+	s := FromSlice([]int{-1, -2})
+	s.Grow(10000)
+
+	if s.Size() != 2 || !s.Contains(-1) || !s.Contains(-2) {
+		t.Errorf("Set after Grow() = %v, want [-1 -2]", s.ToSlice())
+	}
+
+	for i := 0; i < 10000; i++ {
+		s.Add(i)
+	}
+
+	if s.Size() != 10002 {
+		t.Errorf("Set Size() = %d after batch insert, want 10002", s.Size())
+	}
+
+	for i := 0; i < 10000; i++ {
+		if !s.Contains(i) {
+			t.Fatalf("Set Contains(%d) = false after batch insert, want true", i)
+		}
+	}
+
+	// A non-positive n leaves the Set unchanged
+	s.Grow(0)
+	s.Grow(-5)
+
+	if s.Size() != 10002 {
+		t.Errorf("Set Size() = %d after Grow(0), want 10002", s.Size())
+	}
+}
+
 func TestSet_Clone(t *testing.T) {
 	original := FromSlice([]string{"x", "y", "z"})
 	clone := original.Clone()
@@ -431,6 +563,36 @@ func TestSet_Union(t *testing.T) {
 	}
 }
 
+func TestSet_Merge(t *testing.T) {
+	s1 := FromSlice([]int{1, 2, 3})
+	s2 := FromSlice([]int{3, 4, 5})
+
+	s1.Merge(s2)
+
+	if !s1.Equals(FromSlice([]int{1, 2, 3, 4, 5})) {
+		t.Errorf("s1.Merge(s2) = %v, want %v", s1.ToSlice(), []int{1, 2, 3, 4, 5})
+	}
+
+	// Ensure other set is not modified
+	if !s2.Equals(FromSlice([]int{3, 4, 5})) {
+		t.Error("Original set s2 modified by Merge operation")
+	}
+
+	// Merge with empty set
+	s1.Merge(New[int]())
+
+	if s1.Size() != 5 {
+		t.Errorf("Expected size 5 after merging empty set. Got %d", s1.Size())
+	}
+
+	// Merge with itself
+	s1.Merge(s1)
+
+	if s1.Size() != 5 {
+		t.Errorf("Expected size 5 after merging with itself. Got %d", s1.Size())
+	}
+}
+
 func TestSet_Intersection(t *testing.T) {
 	s1 := FromSlice([]int{1, 2, 3, 6})
 	s2 := FromSlice([]int{3, 4, 5, 6})
@@ -582,6 +744,170 @@ func TestSet_SymmetricDifference(t *testing.T) {
 	}
 }
 
+func TestSet_FilterCount(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	scenarios := []struct {
+		name            string
+		input           []int
+		expected        []int
+		expectedRemoved int
+	}{
+		{"All match", []int{2, 4, 6}, []int{2, 4, 6}, 0},
+		{"None match", []int{1, 3, 5}, []int{}, 3},
+		{"Some match", []int{1, 2, 3, 4, 5}, []int{2, 4}, 3},
+		{"Empty set", []int{}, []int{}, 0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			s := FromSlice(scenario.input)
+			result, removed := s.FilterCount(isEven)
+
+			if !result.Equals(FromSlice(scenario.expected)) {
+				t.Errorf("FilterCount() = %v, want %v", result.ToSlice(), scenario.expected)
+			}
+
+			if removed != scenario.expectedRemoved {
+				t.Errorf("Expected %d removed elements. Got %d", scenario.expectedRemoved, removed)
+			}
+
+			if !s.Equals(FromSlice(scenario.input)) {
+				t.Errorf("Expected original set to be unchanged. Got %v", s.ToSlice())
+			}
+		})
+	}
+}
+
+func TestSet_UpdateOperations(t *testing.T) {
+	scenarios := []struct {
+		name  string
+		left  []int
+		right []int
+	}{
+		{"Overlapping sets", []int{1, 2, 3, 6}, []int{3, 4, 5, 6}},
+		{"Disjoint sets", []int{1, 2}, []int{3, 4}},
+		{"Empty other", []int{1, 2}, []int{}},
+		{"Empty receiver", []int{}, []int{1, 2}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			other := FromSlice(scenario.right)
+
+			s := FromSlice(scenario.left)
+			expected := s.Difference(other)
+			s.DifferenceUpdate(other)
+			if !s.Equals(expected) {
+				t.Errorf("DifferenceUpdate() = %v, want %v", s.ToSlice(), expected.ToSlice())
+			}
+
+			s = FromSlice(scenario.left)
+			expected = s.Intersection(other)
+			s.IntersectionUpdate(other)
+			if !s.Equals(expected) {
+				t.Errorf("IntersectionUpdate() = %v, want %v", s.ToSlice(), expected.ToSlice())
+			}
+
+			s = FromSlice(scenario.left)
+			expected = s.SymmetricDifference(other)
+			s.SymmetricDifferenceUpdate(other)
+			if !s.Equals(expected) {
+				t.Errorf("SymmetricDifferenceUpdate() = %v, want %v", s.ToSlice(), expected.ToSlice())
+			}
+
+			if !other.Equals(FromSlice(scenario.right)) {
+				t.Errorf("Expected other to be unchanged. Got %v", other.ToSlice())
+			}
+		})
+	}
+
+	t.Run("Update with itself", func(t *testing.T) {
+		s := FromSlice([]int{1, 2, 3})
+		s.IntersectionUpdate(s)
+		if s.Size() != 3 {
+			t.Errorf("Expected size 3 after IntersectionUpdate with itself. Got %d", s.Size())
+		}
+
+		s.SymmetricDifferenceUpdate(s)
+		if !s.IsEmpty() {
+			t.Errorf("Expected empty set after SymmetricDifferenceUpdate with itself. Got %v", s.ToSlice())
+		}
+
+		s = FromSlice([]int{1, 2, 3})
+		s.DifferenceUpdate(s)
+		if !s.IsEmpty() {
+			t.Errorf("Expected empty set after DifferenceUpdate with itself. Got %v", s.ToSlice())
+		}
+	})
+}
+
+func TestSet_OperationSizes(t *testing.T) {
+	scenarios := []struct {
+		name string
+		s1   *Set[int]
+		s2   *Set[int]
+	}{
+		{"Partial overlap", FromSlice([]int{1, 2, 3, 4}), FromSlice([]int{3, 4, 5, 6, 7})},
+		{"Disjoint sets", FromSlice([]int{1, 2}), FromSlice([]int{3, 4})},
+		{"Identical sets", FromSlice([]int{1, 2, 3}), FromSlice([]int{1, 2, 3})},
+		{"Subset", FromSlice([]int{2}), FromSlice([]int{1, 2, 3})},
+		{"One empty set", FromSlice([]int{1, 2, 3}), New[int]()},
+		{"Both empty", New[int](), New[int]()},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			if got, want := scenario.s1.IntersectionSize(scenario.s2), scenario.s1.Intersection(scenario.s2).Size(); got != want {
+				t.Errorf("IntersectionSize() = %d, want %d", got, want)
+			}
+
+			if got, want := scenario.s2.IntersectionSize(scenario.s1), scenario.s2.Intersection(scenario.s1).Size(); got != want {
+				t.Errorf("reversed IntersectionSize() = %d, want %d", got, want)
+			}
+
+			if got, want := scenario.s1.UnionSize(scenario.s2), scenario.s1.Union(scenario.s2).Size(); got != want {
+				t.Errorf("UnionSize() = %d, want %d", got, want)
+			}
+
+			if got, want := scenario.s1.DifferenceSize(scenario.s2), scenario.s1.Difference(scenario.s2).Size(); got != want {
+				t.Errorf("DifferenceSize() = %d, want %d", got, want)
+			}
+
+			if got, want := scenario.s2.DifferenceSize(scenario.s1), scenario.s2.Difference(scenario.s1).Size(); got != want {
+				t.Errorf("reversed DifferenceSize() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestSet_Intersects(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		s1       *Set[int]
+		s2       *Set[int]
+		expected bool
+	}{
+		{"Overlapping sets", FromSlice([]int{1, 2, 3}), FromSlice([]int{3, 4, 5}), true},
+		{"Small overlapping large", FromSlice([]int{5}), FromSlice([]int{1, 2, 3, 4, 5}), true},
+		{"Disjoint sets", FromSlice([]int{1, 2, 3}), FromSlice([]int{4, 5, 6}), false},
+		{"One empty set", FromSlice([]int{1, 2, 3}), New[int](), false},
+		{"Both empty", New[int](), New[int](), false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			if scenario.s1.Intersects(scenario.s2) != scenario.expected {
+				t.Errorf("s1.Intersects(s2) = %v, want %v", !scenario.expected, scenario.expected)
+			}
+
+			if scenario.s2.Intersects(scenario.s1) != scenario.expected {
+				t.Errorf("s2.Intersects(s1) = %v, want %v", !scenario.expected, scenario.expected)
+			}
+		})
+	}
+}
+
 func TestSet_IsSubsetOf(t *testing.T) {
 	s1 := FromSlice([]int{1, 2})
 	s2 := FromSlice([]int{1, 2, 3})
@@ -678,3 +1004,195 @@ func TestSet_ToSlice(t *testing.T) {
 		t.Errorf("ToSlice() on empty set returned slice of length %d, want 0. Got: %v", len(emptySliceResult), emptySliceResult)
 	}
 }
+
+func TestJaccard(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		a        *Set[int]
+		b        *Set[int]
+		expected float64
+	}{
+		{"Identical sets", FromSlice([]int{1, 2, 3}), FromSlice([]int{1, 2, 3}), 1.0},
+		{"Disjoint sets", FromSlice([]int{1, 2}), FromSlice([]int{3, 4}), 0.0},
+		{"Partial overlap", FromSlice([]int{1, 2, 3}), FromSlice([]int{2, 3, 4, 5}), 0.4},
+		{"One empty set", FromSlice([]int{1, 2, 3}), New[int](), 0.0},
+		{"Both empty", New[int](), New[int](), 1.0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Jaccard(scenario.a, scenario.b)
+			if math.Abs(result-scenario.expected) > 1e-9 {
+				t.Errorf("Jaccard() = %f, want %f", result, scenario.expected)
+			}
+
+			if reversed := Jaccard(scenario.b, scenario.a); reversed != result {
+				t.Errorf("Jaccard() is not symmetric: %f != %f", result, reversed)
+			}
+		})
+	}
+}
+
+func TestMapToSlice(t *testing.T) {
+	type wrapper struct {
+		Value  int
+		Labels []string
+	}
+
+	s := FromSlice([]int{1, 2, 3})
+
+	result := MapToSlice(s, func(n int) wrapper {
+		return wrapper{Value: n * 10, Labels: []string{"n"}}
+	})
+
+	if len(result) != s.Size() {
+		t.Fatalf("Expected %d results. Got %d", s.Size(), len(result))
+	}
+
+	// The order is arbitrary, so sort before comparing.
+	values := make([]int, len(result))
+	for i, w := range result {
+		values[i] = w.Value
+
+		if len(w.Labels) != 1 || w.Labels[0] != "n" {
+			t.Errorf("Expected labels %v. Got %v", []string{"n"}, w.Labels)
+		}
+	}
+	slices.Sort(values)
+
+	if !slices.Equal(values, []int{10, 20, 30}) {
+		t.Errorf("MapToSlice() = %v, want %v", values, []int{10, 20, 30})
+	}
+
+	if empty := MapToSlice(New[int](), func(n int) int { return n }); empty == nil || len(empty) != 0 {
+		t.Errorf("Expected an empty non-nil slice. Got %#v", empty)
+	}
+}
+
+func TestSet_Gob(t *testing.T) {
+	t.Run("Round trip ints", func(t *testing.T) {
+		s := FromSlice([]int{1, 2, 3})
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		decoded := New[int]()
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !decoded.Equals(s) {
+			t.Errorf("Expected %v. Got %v", s.ToSlice(), decoded.ToSlice())
+		}
+	})
+
+	t.Run("Round trip strings inside a struct", func(t *testing.T) {
+		type snapshot struct {
+			Tags *Set[string]
+		}
+
+		original := snapshot{Tags: FromSlice([]string{"go", "sets", "gob"})}
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(original); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var decoded snapshot
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if decoded.Tags == nil || !decoded.Tags.Equals(original.Tags) {
+			t.Errorf("Expected %v. Got %v", original.Tags.ToSlice(), decoded.Tags)
+		}
+	})
+
+	t.Run("Decode replaces contents", func(t *testing.T) {
+		data, err := FromSlice([]int{1, 2}).GobEncode()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		s := FromSlice([]int{7, 8, 9})
+		if err := s.GobDecode(data); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !s.Equals(FromSlice([]int{1, 2})) {
+			t.Errorf("Expected %v. Got %v", []int{1, 2}, s.ToSlice())
+		}
+	})
+
+	t.Run("Empty set", func(t *testing.T) {
+		data, err := New[int]().GobEncode()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		s := FromSlice([]int{1})
+		if err := s.GobDecode(data); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !s.IsEmpty() {
+			t.Errorf("Expected an empty set. Got %v", s.ToSlice())
+		}
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		s := FromSlice([]int{1, 2})
+
+		if err := s.GobDecode([]byte("not gob")); err == nil {
+			t.Error("Expected an error when decoding invalid data")
+		}
+
+		if !s.Equals(FromSlice([]int{1, 2})) {
+			t.Errorf("Expected set to be unchanged after a failed decode. Got %v", s.ToSlice())
+		}
+	})
+}
+
+func TestEachSorted(t *testing.T) {
+	t.Run("Int set", func(t *testing.T) {
+		var indices, values []int
+
+		EachSorted(FromSlice([]int{5, 3, 9, 1, 3}), func(i int, v int) {
+			indices = append(indices, i)
+			values = append(values, v)
+		})
+
+		if !slices.Equal(indices, []int{0, 1, 2, 3}) {
+			t.Errorf("EachSorted() indices = %v, want %v", indices, []int{0, 1, 2, 3})
+		}
+
+		if !slices.Equal(values, []int{1, 3, 5, 9}) {
+			t.Errorf("EachSorted() values = %v, want %v", values, []int{1, 3, 5, 9})
+		}
+	})
+
+	t.Run("String set", func(t *testing.T) {
+		var lines []string
+
+		EachSorted(FromSlice([]string{"pear", "apple", "fig"}), func(i int, v string) {
+			lines = append(lines, strconv.Itoa(i)+":"+v)
+		})
+
+		expected := []string{"0:apple", "1:fig", "2:pear"}
+		if !slices.Equal(lines, expected) {
+			t.Errorf("EachSorted() = %v, want %v", lines, expected)
+		}
+	})
+
+	t.Run("Empty set", func(t *testing.T) {
+		called := false
+
+		EachSorted(New[int](), func(int, int) { called = true })
+
+		if called {
+			t.Error("Expected f not to be called for an empty set")
+		}
+	})
+}
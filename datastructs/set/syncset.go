@@ -59,6 +59,19 @@ func (s *SyncSet[T]) Pop() (T, bool) {
 	return s.set.Pop()
 }
 
+// PopFunc atomically removes and returns an arbitrary element for which pred
+// returns true. If no element matches, the zero value of T and false are returned
+//
+// pred is called while the SyncSet is locked, so it must not call methods on the same SyncSet
+//
+// Note: When several elements match, which one is popped is non-deterministic due to Go's map iteration order
+func (s *SyncSet[T]) PopFunc(pred func(T) bool) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.PopFunc(pred)
+}
+
 // Peek returns an arbitrary element from the SyncSet without removing it
 //
 // Note: The selection of which element to peek is non-deterministic due to Go's map iteration order
@@ -77,6 +90,29 @@ func (s *SyncSet[T]) Size() int {
 	return s.set.Size()
 }
 
+// Stats returns the number of elements in the SyncSet together with up to
+// sampleN of its elements, both read under a single lock so they always
+// describe the same state
+//
+// Note: The sampled elements and their order are arbitrary due to Go's map iteration order
+func (s *SyncSet[T]) Stats(sampleN int) (size int, sample []T) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	size = s.set.Size()
+	sample = make([]T, 0, max(0, min(sampleN, size)))
+
+	for item := range s.set.items {
+		if len(sample) == cap(sample) {
+			break
+		}
+
+		sample = append(sample, item)
+	}
+
+	return size, sample
+}
+
 // IsEmpty returns true if the SyncSet contains no elements
 func (s *SyncSet[T]) IsEmpty() bool {
 	s.mu.RLock()
@@ -103,6 +139,31 @@ func (s *SyncSet[T]) Iter() func(func(T) bool) {
 	}
 }
 
+// ForEach calls f for every element in the SyncSet while holding the read lock
+//
+// Unlike Iter, no snapshot is taken, which makes ForEach cheaper for pure reads.
+// f must not mutate the SyncSet (directly or indirectly) or it will deadlock;
+// use ForEachMut for mutating scans
+func (s *SyncSet[T]) ForEach(f func(T)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for item := range s.set.items {
+		f(item)
+	}
+}
+
+// ForEachMut calls f with the underlying Set while holding the write lock,
+// allowing f to safely inspect and mutate the elements as a single atomic operation
+//
+// f must not retain the Set after it returns or call methods on the SyncSet itself
+func (s *SyncSet[T]) ForEachMut(f func(*Set[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f(s.set)
+}
+
 // Remove deletes an item from the SyncSet and returns whether it was present
 func (s *SyncSet[T]) Remove(item T) bool {
 	s.mu.Lock()
@@ -119,6 +180,15 @@ func (s *SyncSet[T]) Clear() {
 	s.set.Clear()
 }
 
+// Grow ensures the SyncSet has room for at least n more elements without
+// rehashing during later inserts
+func (s *SyncSet[T]) Grow(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Grow(n)
+}
+
 // Clone creates a new Set with the same elements
 func (s *SyncSet[T]) Clone() *SyncSet[T] {
 	s.mu.RLock()
@@ -143,6 +213,19 @@ func (s *SyncSet[T]) Union(other *SyncSet[T]) *SyncSet[T] {
 	return FromSet(s.set.Union(other.set))
 }
 
+// Merge adds all elements of other into s in place, avoiding the allocation
+// of a new SyncSet that Union requires
+func (s *SyncSet[T]) Merge(other *SyncSet[T]) {
+	// Merging a set into itself is a no-op
+	if s == other {
+		return
+	}
+
+	defer s.lockForUpdate(other)()
+
+	s.set.Merge(other.set)
+}
+
 // Intersection returns a new SyncSet containing elements present in both SyncSets
 func (s *SyncSet[T]) Intersection(other *SyncSet[T]) *SyncSet[T] {
 	// Lock both in address order to avoid deadlock
@@ -185,6 +268,101 @@ func (s *SyncSet[T]) SymmetricDifference(other *SyncSet[T]) *SyncSet[T] {
 	return FromSet(s.set.SymmetricDifference(other.set))
 }
 
+// DifferenceUpdate removes every element of other from s in place, avoiding
+// the allocation of a new SyncSet that Difference requires
+func (s *SyncSet[T]) DifferenceUpdate(other *SyncSet[T]) {
+	defer s.lockForUpdate(other)()
+
+	s.set.DifferenceUpdate(other.set)
+}
+
+// IntersectionUpdate removes every element of s that isn't in other in place,
+// avoiding the allocation of a new SyncSet that Intersection requires
+func (s *SyncSet[T]) IntersectionUpdate(other *SyncSet[T]) {
+	defer s.lockForUpdate(other)()
+
+	s.set.IntersectionUpdate(other.set)
+}
+
+// SymmetricDifferenceUpdate updates s in place to hold the elements in either
+// SyncSet but not in both, avoiding the allocation of a new SyncSet that
+// SymmetricDifference requires
+func (s *SyncSet[T]) SymmetricDifferenceUpdate(other *SyncSet[T]) {
+	defer s.lockForUpdate(other)()
+
+	s.set.SymmetricDifferenceUpdate(other.set)
+}
+
+// FilterCount returns a new SyncSet holding the elements for which pred
+// returns true, along with the number of elements that didn't match
+func (s *SyncSet[T]) FilterCount(pred func(T) bool) (*SyncSet[T], int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	filtered, removed := s.set.FilterCount(pred)
+
+	return &SyncSet[T]{set: filtered}, removed
+}
+
+// IntersectionSize returns the number of elements present in both SyncSets
+// without allocating a result set
+func (s *SyncSet[T]) IntersectionSize(other *SyncSet[T]) int {
+	// Lock both in address order to avoid deadlock
+	first, second := utils.SortByAddress(s, other)
+
+	first.mu.RLock()
+	defer first.mu.RUnlock()
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+
+	return s.set.IntersectionSize(other.set)
+}
+
+// UnionSize returns the number of elements present in either SyncSet
+// without allocating a result set
+func (s *SyncSet[T]) UnionSize(other *SyncSet[T]) int {
+	// Lock both in address order to avoid deadlock
+	first, second := utils.SortByAddress(s, other)
+
+	first.mu.RLock()
+	defer first.mu.RUnlock()
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+
+	return s.set.UnionSize(other.set)
+}
+
+// DifferenceSize returns the number of elements in s that are not in other
+// without allocating a result set
+func (s *SyncSet[T]) DifferenceSize(other *SyncSet[T]) int {
+	// Lock both in address order to avoid deadlock
+	first, second := utils.SortByAddress(s, other)
+
+	first.mu.RLock()
+	defer first.mu.RUnlock()
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+
+	return s.set.DifferenceSize(other.set)
+}
+
+// Intersects returns true if s and other have at least one element in common
+func (s *SyncSet[T]) Intersects(other *SyncSet[T]) bool {
+	// Lock both in address order to avoid deadlock
+	first, second := utils.SortByAddress(s, other)
+
+	first.mu.RLock()
+	defer first.mu.RUnlock()
+
+	second.mu.RLock()
+	defer second.mu.RUnlock()
+
+	return s.set.Intersects(other.set)
+}
+
 // IsSubsetOf returns true if all elements in s are also in other
 func (s *SyncSet[T]) IsSubsetOf(other *SyncSet[T]) bool {
 	// Lock both in address order to avoid deadlock
@@ -220,3 +398,52 @@ func (s *SyncSet[T]) ToSlice() []T {
 
 	return s.set.ToSlice()
 }
+
+// GobEncode implements the gob.GobEncoder interface by encoding the elements
+// of the SyncSet as a slice
+func (s *SyncSet[T]) GobEncode() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface by replacing the contents
+// of the SyncSet with the decoded elements
+//
+// The SyncSet is left unchanged if decoding fails
+func (s *SyncSet[T]) GobDecode(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.set == nil {
+		s.set = New[T]()
+	}
+
+	return s.set.GobDecode(data)
+}
+
+// lockForUpdate write locks s and read locks other in address order to avoid
+// deadlock, returning a function that releases both locks. If other is s, only
+// the write lock is taken
+func (s *SyncSet[T]) lockForUpdate(other *SyncSet[T]) func() {
+	if s == other {
+		s.mu.Lock()
+		return s.mu.Unlock
+	}
+
+	first, _ := utils.SortByAddress(s, other)
+
+	if first == s {
+		s.mu.Lock()
+		other.mu.RLock()
+	} else {
+		other.mu.RLock()
+		s.mu.Lock()
+	}
+
+	return func() {
+		other.mu.RUnlock()
+		s.mu.Unlock()
+	}
+}
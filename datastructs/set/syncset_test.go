@@ -1,6 +1,8 @@
 package set
 
 import (
+	"bytes"
+	"encoding/gob"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -132,6 +134,57 @@ func TestSyncSet_Pop(t *testing.T) {
 	}
 }
 
+func TestSyncSet_PopFunc(t *testing.T) {
+	const workers = 4
+	const max = 1000
+
+	var elements []int
+	for i := 0; i < max; i++ {
+		elements = append(elements, i)
+	}
+
+	s := SyncFromSlice(elements)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	claimed := make(map[int]int)
+
+	// Each worker only claims elements in its own residue class, and several
+	// goroutines compete for each class.
+	for w := 0; w < workers*4; w++ {
+		wg.Add(1)
+		go func(class int) {
+			defer wg.Done()
+			for {
+				item, found := s.PopFunc(func(n int) bool { return n%workers == class })
+				if !found {
+					return
+				}
+
+				if item%workers != class {
+					t.Errorf("Worker for class %d claimed %d", class, item)
+				}
+
+				mu.Lock()
+				claimed[item]++
+				mu.Unlock()
+			}
+		}(w % workers)
+	}
+
+	wg.Wait()
+
+	if s.Size() != 0 {
+		t.Errorf("Expected s size to be 0. Got %d", s.Size())
+	}
+
+	for _, element := range elements {
+		if claimed[element] != 1 {
+			t.Errorf("Expected %d to be claimed exactly once. Got %d", element, claimed[element])
+		}
+	}
+}
+
 func TestSyncSet_Peek(t *testing.T) {
 	var elements []int
 	for i := 0; i < 100; i++ {
@@ -191,6 +244,77 @@ func TestSyncSet_Size(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSyncSet_Stats(t *testing.T) {
+	t.Run("Sample is limited by size and sampleN", func(t *testing.T) {
+		s := SyncFromSlice([]int{1, 2, 3, 4, 5})
+
+		scenarios := []struct {
+			sampleN        int
+			expectedLength int
+		}{
+			{3, 3},
+			{5, 5},
+			{10, 5},
+			{0, 0},
+			{-1, 0},
+		}
+
+		for _, scenario := range scenarios {
+			size, sample := s.Stats(scenario.sampleN)
+
+			if size != 5 {
+				t.Errorf("Expected size to be 5. Got %d", size)
+			}
+
+			if len(sample) != scenario.expectedLength {
+				t.Errorf("Expected sample of %d elements for sampleN %d. Got %v", scenario.expectedLength, scenario.sampleN, sample)
+			}
+
+			for _, item := range sample {
+				if !s.Contains(item) {
+					t.Errorf("Sampled element %d is not in the set", item)
+				}
+			}
+		}
+	})
+
+	t.Run("Size and sample stay consistent under concurrent writes", func(t *testing.T) {
+		s := NewSync[int]()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 100; i++ {
+			wg.Add(2)
+
+			go func() {
+				defer wg.Done()
+
+				s.Push(i, i+1000)
+				s.Remove(i - 1)
+			}()
+
+			go func() {
+				defer wg.Done()
+
+				size, sample := s.Stats(10)
+				if len(sample) != min(size, 10) {
+					t.Errorf("Expected sample of %d elements for size %d. Got %v", min(size, 10), size, sample)
+				}
+
+				seen := make(map[int]struct{}, len(sample))
+				for _, item := range sample {
+					if _, ok := seen[item]; ok {
+						t.Errorf("Sampled element %d more than once", item)
+					}
+					seen[item] = struct{}{}
+				}
+			}()
+		}
+
+		wg.Wait()
+	})
+}
+
 func TestSyncSet_IsEmpty(t *testing.T) {
 	var elements []int
 	for i := 0; i < 100; i++ {
@@ -257,6 +381,88 @@ func TestSyncSet_Iter(t *testing.T) {
 	}
 }
 
+func TestSyncSet_ForEach(t *testing.T) {
+	var elements []int
+	for i := 0; i < 100; i++ {
+		elements = append(elements, i)
+	}
+
+	s := SyncFromSlice(elements)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sum := 0
+			count := 0
+			s.ForEach(func(item int) {
+				sum += item
+				count++
+			})
+
+			if count != len(elements) {
+				t.Errorf("Expected ForEach to visit %d elements. Visited %d", len(elements), count)
+			}
+
+			if sum != 4950 {
+				t.Errorf("Expected sum of elements to be 4950. Got %d", sum)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncSet_ForEachMut(t *testing.T) {
+	var elements []int
+	for i := 0; i < 100; i++ {
+		elements = append(elements, i)
+	}
+
+	s := SyncFromSlice(elements)
+
+	var wg sync.WaitGroup
+
+	// Concurrently remove odd numbers and add new even numbers.
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			s.ForEachMut(func(set *Set[int]) {
+				for item := range set.Iter() {
+					if item%2 != 0 {
+						set.Remove(item)
+					}
+				}
+
+				set.Push(1000 + i*2)
+			})
+		}(i)
+	}
+
+	wg.Wait()
+
+	if s.Size() != 60 {
+		t.Errorf("Expected size to be 60. Got %d", s.Size())
+	}
+
+	s.ForEach(func(item int) {
+		if item%2 != 0 {
+			t.Errorf("Expected odd element %d to have been removed", item)
+		}
+	})
+
+	for i := 0; i < 10; i++ {
+		if !s.Contains(1000 + i*2) {
+			t.Errorf("Expected mutation adding %d to persist", 1000+i*2)
+		}
+	}
+}
+
 func TestSyncSet_Remove(t *testing.T) {
 	const goroutines = 50
 	const target = 42
@@ -288,6 +494,30 @@ func TestSyncSet_Remove(t *testing.T) {
 	}
 }
 
+func TestSyncSet_Grow(t *testing.T) {
+	s := NewSync[int]()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			s.Grow(10)
+		}()
+		go func(i int) {
+			defer wg.Done()
+			s.Push(i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if s.Size() != 100 {
+		t.Errorf("SyncSet Size() = %d after concurrent Grow() and Push(), want 100", s.Size())
+	}
+}
+
 func TestSyncSet_Clear(t *testing.T) {
 	const max = 1000
 	var elements []int
@@ -382,6 +612,47 @@ func TestSyncSet_Union(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSyncSet_Merge(t *testing.T) {
+	dst := NewSync[int]()
+	sources := make([]*SyncSet[int], 10)
+	for i := range sources {
+		sources[i] = SyncFromSlice([]int{i * 10, i*10 + 1, i*10 + 2})
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			src := sources[i%len(sources)]
+
+			// Read from both sets in the opposite direction to exercise lock ordering
+			dst.Merge(src)
+			src.Union(dst)
+			dst.Merge(dst)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if dst.Size() != 30 {
+		t.Errorf("Expected size 30. Got %d", dst.Size())
+	}
+
+	for i := range sources {
+		for j := 0; j < 3; j++ {
+			if !dst.Contains(i*10 + j) {
+				t.Errorf("Expected merged set to contain %d", i*10+j)
+			}
+		}
+
+		if sources[i].Size() != 3 {
+			t.Errorf("Expected source %d to be unchanged. Got size %d", i, sources[i].Size())
+		}
+	}
+}
+
 func TestSyncSet_Intersection(t *testing.T) {
 	s1 := SyncFromSlice([]int{1, 2, 3, 6})
 	s2 := SyncFromSlice([]int{3, 4, 5, 6})
@@ -442,6 +713,160 @@ func TestSyncSet_SymmetricDifference(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSyncSet_FilterCount(t *testing.T) {
+	s := SyncFromSlice([]int{1, 2, 3, 4, 5, 6})
+	expected := SyncFromSlice([]int{2, 4, 6})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result, removed := s.FilterCount(func(n int) bool { return n%2 == 0 })
+
+			if !result.Equals(expected) {
+				t.Errorf("FilterCount() = %v, want %v", result.ToSlice(), expected.ToSlice())
+			}
+
+			if removed != 3 {
+				t.Errorf("Expected 3 removed elements. Got %d", removed)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	result, removed := NewSync[int]().FilterCount(func(int) bool { return true })
+	if !result.IsEmpty() || removed != 0 {
+		t.Errorf("Expected an empty result and 0 removed. Got %v and %d", result.ToSlice(), removed)
+	}
+}
+
+func TestSyncSet_UpdateOperations(t *testing.T) {
+	t.Run("Results match non-mutating equivalents", func(t *testing.T) {
+		left := []int{1, 2, 3, 6}
+		other := SyncFromSlice([]int{3, 4, 5, 6})
+
+		s := SyncFromSlice(left)
+		expected := s.Difference(other)
+		s.DifferenceUpdate(other)
+		if !s.Equals(expected) {
+			t.Errorf("DifferenceUpdate() = %v, want %v", s.ToSlice(), expected.ToSlice())
+		}
+
+		s = SyncFromSlice(left)
+		expected = s.Intersection(other)
+		s.IntersectionUpdate(other)
+		if !s.Equals(expected) {
+			t.Errorf("IntersectionUpdate() = %v, want %v", s.ToSlice(), expected.ToSlice())
+		}
+
+		s = SyncFromSlice(left)
+		expected = s.SymmetricDifference(other)
+		s.SymmetricDifferenceUpdate(other)
+		if !s.Equals(expected) {
+			t.Errorf("SymmetricDifferenceUpdate() = %v, want %v", s.ToSlice(), expected.ToSlice())
+		}
+
+		s.SymmetricDifferenceUpdate(s)
+		if !s.IsEmpty() {
+			t.Errorf("Expected empty set after SymmetricDifferenceUpdate with itself. Got %v", s.ToSlice())
+		}
+	})
+
+	t.Run("Concurrent updates in both directions", func(t *testing.T) {
+		a := SyncFromSlice([]int{1, 2, 3})
+		b := SyncFromSlice([]int{2, 3, 4})
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 100; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				a.SymmetricDifferenceUpdate(b)
+				a.IntersectionUpdate(a)
+			}()
+			go func() {
+				defer wg.Done()
+				b.SymmetricDifferenceUpdate(a)
+				b.DifferenceUpdate(NewSync[int]())
+			}()
+		}
+
+		wg.Wait()
+
+		universe := SyncFromSlice([]int{1, 2, 3, 4})
+		if !a.IsSubsetOf(universe) || !b.IsSubsetOf(universe) {
+			t.Errorf("Expected both sets to stay within %v. Got %v and %v", universe.ToSlice(), a.ToSlice(), b.ToSlice())
+		}
+	})
+}
+
+func TestSyncSet_OperationSizes(t *testing.T) {
+	s1 := SyncFromSlice([]int{1, 2, 3, 4})
+	s2 := SyncFromSlice([]int{3, 4, 5, 6, 7})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if got, want := s1.IntersectionSize(s2), s1.Intersection(s2).Size(); got != want {
+				t.Errorf("IntersectionSize() = %d, want %d", got, want)
+			}
+
+			if got, want := s1.UnionSize(s2), s1.Union(s2).Size(); got != want {
+				t.Errorf("UnionSize() = %d, want %d", got, want)
+			}
+
+			if got, want := s1.DifferenceSize(s2), s1.Difference(s2).Size(); got != want {
+				t.Errorf("DifferenceSize() = %d, want %d", got, want)
+			}
+
+			if got, want := s2.DifferenceSize(s1), s2.Difference(s1).Size(); got != want {
+				t.Errorf("reversed DifferenceSize() = %d, want %d", got, want)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncSet_Intersects(t *testing.T) {
+	s1 := SyncFromSlice([]int{1, 2, 3})
+	s2 := SyncFromSlice([]int{3, 4, 5})
+	s3 := SyncFromSlice([]int{6, 7})
+	sEmpty := NewSync[int]()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if !s1.Intersects(s2) || !s2.Intersects(s1) {
+				t.Error("Expected s1 and s2 to intersect.")
+			}
+
+			if s1.Intersects(s3) {
+				t.Error("Expected s1 and s3 to not intersect.")
+			}
+
+			if s1.Intersects(sEmpty) {
+				t.Error("Expected s1 and the empty set to not intersect.")
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
 func TestSyncSet_IsSubsetOf(t *testing.T) {
 	s1 := SyncFromSlice([]int{1, 2})
 	s2 := SyncFromSlice([]int{1, 2, 3})
@@ -573,3 +998,71 @@ func TestSyncSet_ToSlice(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestSyncSet_Gob(t *testing.T) {
+	t.Run("Round trip ints", func(t *testing.T) {
+		s := SyncFromSlice([]int{1, 2, 3})
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		decoded := NewSync[int]()
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !decoded.Equals(s) {
+			t.Errorf("Expected %v. Got %v", s.ToSlice(), decoded.ToSlice())
+		}
+	})
+
+	t.Run("Round trip strings into a zero value", func(t *testing.T) {
+		s := SyncFromSlice([]string{"a", "b"})
+
+		data, err := s.GobEncode()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var decoded SyncSet[string]
+		if err := decoded.GobDecode(data); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !decoded.Equals(s) {
+			t.Errorf("Expected %v. Got %v", s.ToSlice(), decoded.ToSlice())
+		}
+	})
+
+	t.Run("Concurrent encode and decode", func(t *testing.T) {
+		s := SyncFromSlice([]int{1, 2, 3})
+		data, err := s.GobEncode()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				if _, err := s.GobEncode(); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				if err := s.GobDecode(data); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if !s.Equals(SyncFromSlice([]int{1, 2, 3})) {
+			t.Errorf("Expected %v. Got %v", []int{1, 2, 3}, s.ToSlice())
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package trie
+
+import "sync"
+
+// SyncTrie is a generic prefix tree with thread-safety.
+//
+// V represents the type of values stored in the trie.
+type SyncTrie[V any] struct {
+	mu   sync.RWMutex
+	trie *Trie[V]
+}
+
+// NewSync returns a new empty SyncTrie.
+func NewSync[V any]() *SyncTrie[V] {
+	return &SyncTrie[V]{
+		trie: New[V](),
+	}
+}
+
+// SyncFromTrie creates a new SyncTrie from a given Trie.
+// This results in a copy so the new trie won't be connected to the original.
+func SyncFromTrie[V any](src *Trie[V]) *SyncTrie[V] {
+	return &SyncTrie[V]{
+		trie: src.Clone(),
+	}
+}
+
+// Insert stores the value for the given key, replacing any existing value.
+func (t *SyncTrie[V]) Insert(key string, v V) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.trie.Insert(key, v)
+}
+
+// Get returns the value stored for the given key and whether it was found.
+func (t *SyncTrie[V]) Get(key string) (V, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.trie.Get(key)
+}
+
+// HasPrefix returns true if at least one key in the trie starts with the given prefix.
+// The empty prefix matches any key.
+func (t *SyncTrie[V]) HasPrefix(prefix string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.trie.HasPrefix(prefix)
+}
+
+// WithPrefix returns all keys in the trie that start with the given prefix, in
+// lexicographical order. The empty prefix returns every key.
+func (t *SyncTrie[V]) WithPrefix(prefix string) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.trie.WithPrefix(prefix)
+}
+
+// Len returns the number of keys in the trie.
+func (t *SyncTrie[V]) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.trie.Len()
+}
+
+// Clone creates a new SyncTrie with the same keys and values.
+func (t *SyncTrie[V]) Clone() *SyncTrie[V] {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return &SyncTrie[V]{
+		trie: t.trie.Clone(),
+	}
+}
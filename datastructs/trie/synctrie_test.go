@@ -0,0 +1,66 @@
+package trie
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncTrie_NewSync(t *testing.T) {
+	tr := NewSync[int]()
+
+	if tr == nil || tr.trie == nil {
+		t.Fatal("Expected tr and tr.trie to not be nil")
+	}
+
+	if tr.Len() != 0 {
+		t.Errorf("Expected new trie to be empty. Got length %d", tr.Len())
+	}
+}
+
+func TestSyncTrie_SyncFromTrie(t *testing.T) {
+	src := New[int]()
+	src.Insert("a", 1)
+
+	dst := SyncFromTrie(src)
+	dst.Insert("b", 2)
+
+	if src.Len() != 1 {
+		t.Errorf("Expected source to be unchanged. Got length %d", src.Len())
+	}
+
+	if !slices.Equal(dst.WithPrefix(""), []string{"a", "b"}) {
+		t.Errorf("Expected %#v. Got %#v", []string{"a", "b"}, dst.WithPrefix(""))
+	}
+}
+
+func TestSyncTrie_Concurrency(t *testing.T) {
+	const max = 1000
+
+	tr := NewSync[int]()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < max; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("key-%d", i)
+			tr.Insert(key, i)
+			tr.Get(key)
+			tr.HasPrefix("key-")
+			tr.WithPrefix(key)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if tr.Len() != max {
+		t.Errorf("Expected length %d. Got %d", max, tr.Len())
+	}
+
+	if keys := tr.WithPrefix("key-1"); len(keys) != 111 {
+		t.Errorf("Expected 111 keys with prefix \"key-1\". Got %d", len(keys))
+	}
+}
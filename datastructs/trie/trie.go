@@ -0,0 +1,176 @@
+// Package trie provides a generic prefix tree mapping string keys to values,
+// useful for prefix lookups such as autocomplete.
+package trie
+
+import (
+	"slices"
+	"strings"
+)
+
+// node is a single byte of a key in the trie. A node marks the end of a key when
+// terminal is true, in which case value holds the key's value.
+type node[V any] struct {
+	children map[byte]*node[V]
+	terminal bool
+	value    V
+}
+
+func newNode[V any]() *node[V] {
+	return &node[V]{
+		children: make(map[byte]*node[V]),
+	}
+}
+
+// Trie is a generic prefix tree with one node per byte of each key.
+//
+// Keys are stored byte by byte rather than rune by rune, so any string is a
+// valid key, including ones that are not valid UTF-8. Prefixes are matched the
+// same way as strings.HasPrefix.
+//
+// V represents the type of values stored in the trie.
+type Trie[V any] struct {
+	root *node[V]
+	size int
+}
+
+// New returns a new empty Trie.
+func New[V any]() *Trie[V] {
+	return &Trie[V]{
+		root: newNode[V](),
+	}
+}
+
+// FromSyncTrie creates a new Trie from a given SyncTrie.
+// This results in a copy so the new trie won't be connected to the original.
+func FromSyncTrie[V any](src *SyncTrie[V]) *Trie[V] {
+	src.mu.RLock()
+	defer src.mu.RUnlock()
+
+	return src.trie.Clone()
+}
+
+// Insert stores the value for the given key, replacing any existing value.
+func (t *Trie[V]) Insert(key string, v V) {
+	n := t.root
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = newNode[V]()
+			n.children[key[i]] = child
+		}
+
+		n = child
+	}
+
+	if !n.terminal {
+		n.terminal = true
+		t.size++
+	}
+
+	n.value = v
+}
+
+// Get returns the value stored for the given key and whether it was found.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.find(key)
+	if n == nil || !n.terminal {
+		var zero V
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+// HasPrefix returns true if at least one key in the trie starts with the given prefix.
+// The empty prefix matches any key.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	n := t.find(prefix)
+	if n == nil {
+		return false
+	}
+
+	return n.terminal || len(n.children) > 0
+}
+
+// WithPrefix returns all keys in the trie that start with the given prefix, in
+// lexicographical order. The empty prefix returns every key.
+func (t *Trie[V]) WithPrefix(prefix string) []string {
+	keys := []string{}
+
+	n := t.find(prefix)
+	if n == nil {
+		return keys
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	collect(n, &b, &keys)
+
+	return keys
+}
+
+// Len returns the number of keys in the trie.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// Clone creates a new Trie with the same keys and values.
+func (t *Trie[V]) Clone() *Trie[V] {
+	return &Trie[V]{
+		root: cloneNode(t.root),
+		size: t.size,
+	}
+}
+
+// find walks the trie along the given key and returns the node it ends at, or
+// nil if the path doesn't exist.
+func (t *Trie[V]) find(key string) *node[V] {
+	n := t.root
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			return nil
+		}
+
+		n = child
+	}
+
+	return n
+}
+
+// collect appends every key under n to keys, visiting children in byte order
+// so that the result is sorted. Byte order matches rune order for valid UTF-8.
+// b holds the key built up to n.
+func collect[V any](n *node[V], b *strings.Builder, keys *[]string) {
+	if n.terminal {
+		*keys = append(*keys, b.String())
+	}
+
+	edges := make([]byte, 0, len(n.children))
+	for c := range n.children {
+		edges = append(edges, c)
+	}
+	slices.Sort(edges)
+
+	prefix := b.String()
+	for _, c := range edges {
+		b.Reset()
+		b.WriteString(prefix)
+		b.WriteByte(c)
+		collect(n.children[c], b, keys)
+	}
+}
+
+func cloneNode[V any](n *node[V]) *node[V] {
+	clone := &node[V]{
+		children: make(map[byte]*node[V], len(n.children)),
+		terminal: n.terminal,
+		value:    n.value,
+	}
+
+	for c, child := range n.children {
+		clone.children[c] = cloneNode(child)
+	}
+
+	return clone
+}
@@ -0,0 +1,193 @@
+package trie
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrie_New(t *testing.T) {
+	tr := New[int]()
+
+	if tr == nil || tr.root == nil {
+		t.Fatal("Expected tr and tr.root to not be nil")
+	}
+
+	if tr.Len() != 0 {
+		t.Errorf("Expected new trie to be empty. Got length %d", tr.Len())
+	}
+}
+
+func TestTrie_InsertGet(t *testing.T) {
+	tr := New[int]()
+	tr.Insert("car", 1)
+	tr.Insert("cart", 2)
+	tr.Insert("care", 3)
+	tr.Insert("", 0)
+
+	scenarios := []struct {
+		key      string
+		expected int
+		found    bool
+	}{
+		{"car", 1, true},
+		{"cart", 2, true},
+		{"care", 3, true},
+		{"", 0, true},
+		{"ca", 0, false},
+		{"cars", 0, false},
+		{"dog", 0, false},
+	}
+
+	for _, scenario := range scenarios {
+		v, ok := tr.Get(scenario.key)
+		if v != scenario.expected || ok != scenario.found {
+			t.Errorf("Expected Get(%q) to return (%d, %v). Got (%d, %v)", scenario.key, scenario.expected, scenario.found, v, ok)
+		}
+	}
+
+	if tr.Len() != 4 {
+		t.Errorf("Expected length 4. Got %d", tr.Len())
+	}
+
+	// Re-inserting replaces the value without changing the length.
+	tr.Insert("car", 10)
+
+	if v, _ := tr.Get("car"); v != 10 {
+		t.Errorf("Expected updated value 10. Got %d", v)
+	}
+
+	if tr.Len() != 4 {
+		t.Errorf("Expected length 4 after update. Got %d", tr.Len())
+	}
+}
+
+func TestTrie_Unicode(t *testing.T) {
+	tr := New[string]()
+	tr.Insert("héllo", "a")
+	tr.Insert("hé", "b")
+
+	if v, ok := tr.Get("héllo"); !ok || v != "a" {
+		t.Errorf("Expected Get(\"héllo\") to return (\"a\", true). Got (%q, %v)", v, ok)
+	}
+
+	if keys := tr.WithPrefix("hé"); !slices.Equal(keys, []string{"hé", "héllo"}) {
+		t.Errorf("Expected %#v. Got %#v", []string{"hé", "héllo"}, keys)
+	}
+}
+
+func TestTrie_InvalidUTF8(t *testing.T) {
+	tr := New[int]()
+	tr.Insert("\xff", 1)
+	tr.Insert("\xfe", 2)
+	tr.Insert("a\xffb", 3)
+
+	if tr.Len() != 3 {
+		t.Errorf("Expected 3 distinct keys. Got %d", tr.Len())
+	}
+
+	if v, ok := tr.Get("\xff"); !ok || v != 1 {
+		t.Errorf("Expected Get(%q) to return (1, true). Got (%d, %v)", "\xff", v, ok)
+	}
+
+	if v, ok := tr.Get("\xfe"); !ok || v != 2 {
+		t.Errorf("Expected Get(%q) to return (2, true). Got (%d, %v)", "\xfe", v, ok)
+	}
+
+	if _, ok := tr.Get("\uFFFD"); ok {
+		t.Error("Expected the replacement character to not be a key")
+	}
+
+	if keys := tr.WithPrefix(""); !slices.Equal(keys, []string{"a\xffb", "\xfe", "\xff"}) {
+		t.Errorf("Expected %#v. Got %#v", []string{"a\xffb", "\xfe", "\xff"}, keys)
+	}
+}
+
+func TestTrie_HasPrefix(t *testing.T) {
+	tr := New[int]()
+
+	if tr.HasPrefix("") {
+		t.Error("Expected empty trie to not have the empty prefix")
+	}
+
+	tr.Insert("apple", 1)
+	tr.Insert("app", 2)
+
+	scenarios := []struct {
+		prefix   string
+		expected bool
+	}{
+		{"", true},
+		{"a", true},
+		{"app", true},
+		{"appl", true},
+		{"apple", true},
+		{"apples", false},
+		{"b", false},
+	}
+
+	for _, scenario := range scenarios {
+		if result := tr.HasPrefix(scenario.prefix); result != scenario.expected {
+			t.Errorf("Expected HasPrefix(%q) to be %v. Got %v", scenario.prefix, scenario.expected, result)
+		}
+	}
+}
+
+func TestTrie_WithPrefix(t *testing.T) {
+	tr := New[int]()
+	for i, key := range []string{"tea", "ten", "to", "inn", "in", "tent", "i"} {
+		tr.Insert(key, i)
+	}
+
+	scenarios := []struct {
+		prefix   string
+		expected []string
+	}{
+		{"", []string{"i", "in", "inn", "tea", "ten", "tent", "to"}},
+		{"t", []string{"tea", "ten", "tent", "to"}},
+		{"te", []string{"tea", "ten", "tent"}},
+		{"ten", []string{"ten", "tent"}},
+		{"in", []string{"in", "inn"}},
+		{"x", []string{}},
+		{"tents", []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		result := tr.WithPrefix(scenario.prefix)
+		if !slices.Equal(result, scenario.expected) {
+			t.Errorf("Expected WithPrefix(%q) to be %#v. Got %#v", scenario.prefix, scenario.expected, result)
+		}
+	}
+}
+
+func TestTrie_Clone(t *testing.T) {
+	tr := New[int]()
+	tr.Insert("a", 1)
+
+	clone := tr.Clone()
+	clone.Insert("a", 2)
+	clone.Insert("ab", 3)
+
+	if v, _ := tr.Get("a"); v != 1 || tr.Len() != 1 {
+		t.Errorf("Expected original to be unchanged. Got value %d and length %d", v, tr.Len())
+	}
+
+	if !slices.Equal(clone.WithPrefix(""), []string{"a", "ab"}) {
+		t.Errorf("Expected %#v. Got %#v", []string{"a", "ab"}, clone.WithPrefix(""))
+	}
+}
+
+func TestTrie_FromSyncTrie(t *testing.T) {
+	src := NewSync[int]()
+	src.Insert("a", 1)
+
+	dst := FromSyncTrie(src)
+	dst.Insert("b", 2)
+
+	if src.Len() != 1 {
+		t.Errorf("Expected source to be unchanged. Got length %d", src.Len())
+	}
+
+	if dst.Len() != 2 {
+		t.Errorf("Expected length 2. Got %d", dst.Len())
+	}
+}
@@ -0,0 +1,29 @@
+package tuple
+
+import "fmt"
+
+// Pair holds two values of possibly different types. Unlike Tuple it is a
+// plain value type, which makes it convenient for zipping slices together
+// and for returning two related values from a function.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a new Pair from the given values.
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{
+		First:  first,
+		Second: second,
+	}
+}
+
+// Values returns both values held by the Pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// String returns a string representation of the Pair formatted like (1, a).
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
@@ -0,0 +1,24 @@
+package tuple
+
+import "testing"
+
+func TestPair_NewPair(t *testing.T) {
+	p := NewPair(1, "a")
+
+	if p.First != 1 || p.Second != "a" {
+		t.Errorf("Expected pair to hold (1, \"a\"). Got (%d, %q)", p.First, p.Second)
+	}
+
+	first, second := p.Values()
+	if first != 1 || second != "a" {
+		t.Errorf("Expected Values() to return (1, \"a\"). Got (%d, %q)", first, second)
+	}
+}
+
+func TestPair_String(t *testing.T) {
+	p := NewPair(1, "a")
+
+	if p.String() != "(1, a)" {
+		t.Errorf("Expected %q. Got %q", "(1, a)", p.String())
+	}
+}
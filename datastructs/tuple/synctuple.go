@@ -56,6 +56,32 @@ func (t *SyncTuple[T]) Set(index int, v T) bool {
 	return t.data.Set(index, v)
 }
 
+// Swap atomically exchanges the elements at indices i and j.
+// It returns true if the operation was successful, or false if either index was out of bounds.
+func (t *SyncTuple[T]) Swap(i, j int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.data.Swap(i, j)
+}
+
+// Fill atomically sets every element of the SyncTuple to v.
+func (t *SyncTuple[T]) Fill(v T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.data.Fill(v)
+}
+
+// IndexOfFunc returns the index of the first element satisfying pred,
+// or -1 if no element does.
+func (t *SyncTuple[T]) IndexOfFunc(pred func(T) bool) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.data.IndexFunc(pred)
+}
+
 // ToSlice returns a copy of the SyncTuple's internal values as a slice.
 func (t *SyncTuple[T]) ToSlice() []T {
 	t.mu.RLock()
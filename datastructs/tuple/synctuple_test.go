@@ -184,6 +184,97 @@ func TestSyncTuple_Set(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSyncTuple_Swap(t *testing.T) {
+	tup := NewSync(islices.ERange(0, 10)...)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 1000; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			if !tup.Swap(i%10, (i*7)%10) {
+				t.Error("Failed to swap elements.")
+			}
+
+			if tup.Swap(i%10, 10) {
+				t.Error("Swapped element outside of valid range.")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+
+			_ = tup.ToSlice()
+		}()
+	}
+
+	wg.Wait()
+
+	result := tup.ToSlice()
+	slices.Sort(result)
+
+	if !slices.Equal(result, islices.ERange(0, 10)) {
+		t.Errorf("Expected swaps to preserve the values %#v. Got %#v", islices.ERange(0, 10), result)
+	}
+}
+
+func TestSyncTuple_Fill(t *testing.T) {
+	tup := NewSync(0, 0, 0, 0)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			tup.Fill(i)
+		}()
+		go func() {
+			defer wg.Done()
+
+			s := tup.ToSlice()
+			for _, v := range s[1:] {
+				if v != s[0] {
+					t.Errorf("Expected every element to be equal. Got %#v", s)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestSyncTuple_IndexOfFunc(t *testing.T) {
+	tup := NewSync(1, 2, 3, 2)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			if index := tup.IndexOfFunc(func(n int) bool { return n == 3 }); index != 2 {
+				t.Errorf("Expected index to be 2. Got %d", index)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+
+			tup.Set(0, i+10)
+		}()
+	}
+
+	wg.Wait()
+
+	if index := tup.IndexOfFunc(func(n int) bool { return n > 1000 }); index != -1 {
+		t.Errorf("Expected index to be -1. Got %d", index)
+	}
+}
+
 func TestSyncTuple_ToSlice(t *testing.T) {
 	scenarios := []struct {
 		name string
@@ -44,6 +44,23 @@ func (t *Tuple[T]) Set(index int, v T) bool {
 	return t.data.Set(index, v)
 }
 
+// Swap exchanges the elements at indices i and j.
+// It returns true if the operation was successful, or false if either index was out of bounds.
+func (t *Tuple[T]) Swap(i, j int) bool {
+	return t.data.Swap(i, j)
+}
+
+// Fill sets every element of the Tuple to v.
+func (t *Tuple[T]) Fill(v T) {
+	t.data.Fill(v)
+}
+
+// IndexOfFunc returns the index of the first element satisfying pred,
+// or -1 if no element does.
+func (t *Tuple[T]) IndexOfFunc(pred func(T) bool) int {
+	return t.data.IndexFunc(pred)
+}
+
 // ToSlice returns a copy of the Tuple's internal values as a slice.
 func (t *Tuple[T]) ToSlice() []T {
 	return t.data.ToSlice()
@@ -53,3 +70,17 @@ func (t *Tuple[T]) ToSlice() []T {
 func (t *Tuple[T]) String() string {
 	return t.data.String()
 }
+
+// IndexOf returns the index of the first element of the Tuple equal to v,
+// or -1 if there is none. It is a function rather than a method because it
+// requires T to be comparable.
+func IndexOf[T comparable](t *Tuple[T], v T) int {
+	return t.IndexOfFunc(func(element T) bool {
+		return element == v
+	})
+}
+
+// Contains reports whether the Tuple holds an element equal to v.
+func Contains[T comparable](t *Tuple[T], v T) bool {
+	return IndexOf(t, v) >= 0
+}
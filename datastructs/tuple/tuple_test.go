@@ -154,6 +154,77 @@ func TestTuple_Set(t *testing.T) {
 	}
 }
 
+func TestTuple_Swap(t *testing.T) {
+	tup := New("a", "b", "c")
+
+	if !tup.Swap(1, 2) {
+		t.Error("Failed to swap elements.")
+	}
+
+	if !slices.Equal(tup.ToSlice(), []string{"a", "c", "b"}) {
+		t.Errorf("Expected %#v. Got %#v", []string{"a", "c", "b"}, tup.ToSlice())
+	}
+
+	if tup.Swap(1, 5) {
+		t.Error("Swapped element outside of valid range.")
+	}
+}
+
+func TestTuple_Fill(t *testing.T) {
+	tup := New(1, 2, 3)
+	tup.Fill(0)
+
+	if !slices.Equal(tup.ToSlice(), []int{0, 0, 0}) {
+		t.Errorf("Expected %#v. Got %#v", []int{0, 0, 0}, tup.ToSlice())
+	}
+}
+
+func TestTuple_IndexOf(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		data          []string
+		value         string
+		expectedIndex int
+	}{
+		{"IndexOf with no elements", []string{}, "a", -1},
+		{"IndexOf with present value", []string{"a", "b", "c"}, "b", 1},
+		{"IndexOf with absent value", []string{"a", "b", "c"}, "d", -1},
+		{"IndexOf with duplicate values", []string{"a", "b", "a", "b"}, "b", 1},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			tup := FromSlice(scenario.data)
+
+			if index := IndexOf(tup, scenario.value); index != scenario.expectedIndex {
+				t.Errorf("Expected index to be %d. Got %d", scenario.expectedIndex, index)
+			}
+
+			if found := Contains(tup, scenario.value); found != (scenario.expectedIndex >= 0) {
+				t.Errorf("Expected Contains to be %t. Got %t", scenario.expectedIndex >= 0, found)
+			}
+		})
+	}
+}
+
+func TestTuple_IndexOfFunc(t *testing.T) {
+	type point struct {
+		X, Y []int
+	}
+
+	tup := New(point{X: []int{1}}, point{X: []int{2}}, point{X: []int{2}, Y: []int{1}})
+
+	index := tup.IndexOfFunc(func(p point) bool { return p.X[0] == 2 })
+	if index != 1 {
+		t.Errorf("Expected index to be 1. Got %d", index)
+	}
+
+	index = tup.IndexOfFunc(func(p point) bool { return len(p.Y) > 1 })
+	if index != -1 {
+		t.Errorf("Expected index to be -1. Got %d", index)
+	}
+}
+
 func TestTuple_ToSlice(t *testing.T) {
 	scenarios := []struct {
 		name string
@@ -0,0 +1,93 @@
+package slices
+
+import (
+	"runtime"
+	"sync"
+)
+
+// Batch splits the input slice s into consecutive batches of the given size and
+// calls f on each batch in order, stopping at the first error f returns.
+//
+// The final batch holds the remaining elements and may be smaller than size. If
+// size is less than or equal to 0, the whole slice is passed to f as a single
+// batch. f is never called for an empty slice.
+//
+// Each batch is a sub-slice of s with its capacity capped to its length, so
+// appending to a batch will not overwrite elements of the following batch.
+//
+// Example:
+//
+//	err := Batch(records, 100, func(batch []Record) error {
+//	    return db.InsertMany(batch)
+//	})
+func Batch[T any, S ~[]T](s S, size int, f func(batch S) error) error {
+	if len(s) == 0 {
+		return nil
+	}
+
+	if size <= 0 {
+		size = len(s)
+	}
+
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+
+		if err := f(s[start:end:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParallelBatch splits the input slice s into consecutive batches of the given
+// size, following the same rules as Batch, and calls f on the batches
+// concurrently using a worker pool. Each batch is handed to a single call of f,
+// so f processes its batch sequentially while other batches are processed in
+// parallel. Batches are not processed in any particular order.
+//
+// f must be safe to call from multiple goroutines.
+//
+// The number of concurrent workers can be controlled via the optional
+// workers parameter. If omitted or set to a non-positive number,
+// the number of logical CPUs (runtime.GOMAXPROCS(0)) is used by default.
+//
+// Example:
+//
+//	ParallelBatch(records, 500, func(batch []Record) {
+//	    db.InsertMany(batch)
+//	}, 4)
+//
+// Panics if f panics; it does not recover from errors within goroutines.
+func ParallelBatch[T any, S ~[]T](s S, batchSize int, f func(batch S), workers ...int) {
+	if len(s) == 0 {
+		return
+	}
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		workerCount = workers[0]
+	}
+
+	batches := make(chan S, workerCount)
+	go func() {
+		for batch := range ChunkSeq(s, batchSize) {
+			batches <- batch
+		}
+		close(batches)
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				f(batch)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,127 @@
+package slices
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	islices "github.com/PsionicAlch/byteforge/internal/functions/slices"
+)
+
+func TestBatch(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{"Exact multiple", []int{1, 2, 3, 4, 5, 6}, 2, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"Remainder batch", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"Size larger than slice", []int{1, 2, 3}, 10, [][]int{{1, 2, 3}}},
+		{"Non-positive size", []int{1, 2, 3}, 0, [][]int{{1, 2, 3}}},
+		{"Empty slice", []int{}, 2, nil},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var batches [][]int
+
+			err := Batch(scenario.input, scenario.size, func(batch []int) error {
+				batches = append(batches, slices.Clone(batch))
+				return nil
+			})
+
+			if err != nil {
+				t.Errorf("Expected no error. Got %v", err)
+			}
+
+			if !slices.EqualFunc(batches, scenario.expected, slices.Equal[[]int]) {
+				t.Errorf("Expected batches to be %#v. Got %#v", scenario.expected, batches)
+			}
+		})
+	}
+
+	t.Run("Error aborts processing", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		calls := 0
+
+		err := Batch([]int{1, 2, 3, 4, 5, 6}, 2, func(batch []int) error {
+			calls++
+			if calls == 2 {
+				return errFailed
+			}
+			return nil
+		})
+
+		if !errors.Is(err, errFailed) {
+			t.Errorf("Expected error %v. Got %v", errFailed, err)
+		}
+
+		if calls != 2 {
+			t.Errorf("Expected f to be called 2 times. Got %d", calls)
+		}
+	})
+
+	t.Run("Appending to a batch doesn't clobber the next", func(t *testing.T) {
+		input := []int{1, 2, 3, 4}
+
+		_ = Batch(input, 2, func(batch []int) error {
+			_ = append(batch, 100)
+			return nil
+		})
+
+		if !slices.Equal(input, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected input to be unchanged. Got %#v", input)
+		}
+	})
+}
+
+func TestParallelBatch(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		input           []int
+		size            int
+		workers         []int
+		expectedBatches int
+	}{
+		{"Exact multiple", islices.ERange(0, 1000), 100, nil, 10},
+		{"Remainder batch", islices.ERange(0, 1005), 100, []int{3}, 11},
+		{"Size larger than slice", islices.ERange(0, 10), 100, nil, 1},
+		{"Non-positive size", islices.ERange(0, 10), 0, nil, 1},
+		{"Negative worker pool", islices.ERange(0, 10), 3, []int{-1}, 4},
+		{"Empty slice", []int{}, 10, nil, 0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var mu sync.Mutex
+			seen := make(map[int]int, len(scenario.input))
+			batches := 0
+
+			ParallelBatch(scenario.input, scenario.size, func(batch []int) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				batches++
+				for _, v := range batch {
+					seen[v]++
+				}
+			}, scenario.workers...)
+
+			if batches != scenario.expectedBatches {
+				t.Errorf("Expected %d batches. Got %d", scenario.expectedBatches, batches)
+			}
+
+			if len(seen) != len(scenario.input) {
+				t.Errorf("Expected %d distinct elements to be processed. Got %d", len(scenario.input), len(seen))
+			}
+
+			for _, v := range scenario.input {
+				if seen[v] != 1 {
+					t.Errorf("Expected %d to be processed exactly once. Got %d", v, seen[v])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package slices
+
+import "iter"
+
+// Chunk splits the input slice s into consecutive chunks of the given size and
+// returns them as a slice of slices.
+//
+// The final chunk holds the remaining elements and may be smaller than size. If
+// size is less than or equal to 0, the whole slice is returned as a single chunk.
+// An empty slice results in no chunks.
+//
+// Each chunk is a sub-slice of s with its capacity capped to its length, so the
+// chunks share memory with s but appending to one will not overwrite another.
+//
+// Example:
+//
+//	chunks := Chunk([]int{1, 2, 3, 4, 5}, 2)
+//	// chunks == [][]int{{1, 2}, {3, 4}, {5}}
+func Chunk[T any, S ~[]T](s S, size int) []S {
+	if len(s) == 0 {
+		return []S{}
+	}
+
+	if size <= 0 {
+		size = len(s)
+	}
+
+	chunks := make([]S, 0, (len(s)+size-1)/size)
+	for chunk := range ChunkSeq(s, size) {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// ChunkSeq returns an iterator that lazily yields consecutive chunks of the
+// input slice s, following the same rules as Chunk.
+//
+// Unlike Chunk, no outer slice holding every chunk is allocated, which makes
+// ChunkSeq better suited to streaming chunks straight into a consumer.
+//
+// Example:
+//
+//	for chunk := range ChunkSeq(records, 100) {
+//	    process(chunk)
+//	}
+func ChunkSeq[T any, S ~[]T](s S, size int) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		if len(s) == 0 {
+			return
+		}
+
+		chunkSize := size
+		if chunkSize <= 0 {
+			chunkSize = len(s)
+		}
+
+		for start := 0; start < len(s); start += chunkSize {
+			end := min(start+chunkSize, len(s))
+
+			if !yield(s[start:end:end]) {
+				return
+			}
+		}
+	}
+}
+
+// Split divides the input slice s into the given number of contiguous parts
+// whose sizes differ by at most one. When the length of s is not evenly
+// divisible, the earlier parts receive the extra elements.
+//
+// If parts is less than or equal to 1, s is returned as a single part. If parts
+// is greater than the length of s, every element gets its own part and the
+// remaining parts are empty, so the result always holds exactly parts slices.
+//
+// Each part is a sub-slice of s with its capacity capped to its length, so the
+// parts share memory with s but appending to one will not overwrite another.
+//
+// Example:
+//
+//	parts := Split([]int{1, 2, 3, 4, 5, 6, 7}, 3)
+//	// parts == [][]int{{1, 2, 3}, {4, 5}, {6, 7}}
+func Split[T any, S ~[]T](s S, parts int) []S {
+	if parts <= 1 {
+		return []S{s[:len(s):len(s)]}
+	}
+
+	size, remainder := len(s)/parts, len(s)%parts
+
+	result := make([]S, parts)
+	start := 0
+	for i := range result {
+		end := start + size
+		if i < remainder {
+			end++
+		}
+
+		result[i] = s[start:end:end]
+		start = end
+	}
+
+	return result
+}
@@ -0,0 +1,99 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+
+	islices "github.com/PsionicAlch/byteforge/internal/functions/slices"
+)
+
+var chunkScenarios = []struct {
+	name     string
+	input    []int
+	size     int
+	expected [][]int
+}{
+	{"Exact multiple", []int{1, 2, 3, 4, 5, 6}, 2, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+	{"With remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+	{"Size of one", []int{1, 2, 3}, 1, [][]int{{1}, {2}, {3}}},
+	{"Size larger than slice", []int{1, 2, 3}, 10, [][]int{{1, 2, 3}}},
+	{"Non-positive size", []int{1, 2, 3}, 0, [][]int{{1, 2, 3}}},
+	{"Empty slice", []int{}, 2, [][]int{}},
+}
+
+func TestChunk(t *testing.T) {
+	for _, scenario := range chunkScenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Chunk(scenario.input, scenario.size)
+
+			if !slices.EqualFunc(result, scenario.expected, slices.Equal[[]int]) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestChunkSeq(t *testing.T) {
+	for _, scenario := range chunkScenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := slices.Collect(ChunkSeq(scenario.input, scenario.size))
+			eager := Chunk(scenario.input, scenario.size)
+
+			if !slices.EqualFunc(result, eager, slices.Equal[[]int]) {
+				t.Errorf("Expected result to match Chunk %#v. Got %#v", eager, result)
+			}
+		})
+	}
+
+	t.Run("Early break", func(t *testing.T) {
+		var result [][]int
+		for chunk := range ChunkSeq(islices.IRange(1, 10), 3) {
+			result = append(result, chunk)
+			if len(result) == 2 {
+				break
+			}
+		}
+
+		expected := [][]int{{1, 2, 3}, {4, 5, 6}}
+		if !slices.EqualFunc(result, expected, slices.Equal[[]int]) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
+
+func TestSplit(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		parts    int
+		expected [][]int
+	}{
+		{"Even division", []int{1, 2, 3, 4, 5, 6}, 3, [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"Remainder goes to earlier parts", []int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{1, 2, 3}, {4, 5}, {6, 7}}},
+		{"Parts greater than length", []int{1, 2}, 4, [][]int{{1}, {2}, {}, {}}},
+		{"Single part", []int{1, 2, 3}, 1, [][]int{{1, 2, 3}}},
+		{"Non-positive parts", []int{1, 2, 3}, 0, [][]int{{1, 2, 3}}},
+		{"Empty slice", []int{}, 2, [][]int{{}, {}}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Split(scenario.input, scenario.parts)
+
+			if !slices.EqualFunc(result, scenario.expected, slices.Equal[[]int]) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Appending to a part does not overwrite the next", func(t *testing.T) {
+		input := []int{1, 2, 3, 4}
+		parts := Split(input, 2)
+
+		_ = append(parts[0], 99)
+
+		if !slices.Equal(input, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected input to be unchanged. Got %#v", input)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package slices
+
+import "github.com/PsionicAlch/byteforge/constraints"
+
+// Clamp returns v bounded to the inclusive range [lo, hi]. If lo is greater
+// than hi, hi is returned.
+//
+// Example:
+//
+//	c := Clamp(15, 0, 10)
+//	// c == 10
+func Clamp[T constraints.Ordered](v, lo, hi T) T {
+	return min(max(v, lo), hi)
+}
+
+// ClampSlice returns a new slice holding each element of the input slice s
+// bounded to the inclusive range [lo, hi]. The input slice is not modified.
+//
+// Example:
+//
+//	clamped := ClampSlice([]int{-5, 3, 12}, 0, 10)
+//	// clamped == []int{0, 3, 10}
+func ClampSlice[T constraints.Ordered, S ~[]T](s S, lo, hi T) S {
+	clamped := make(S, len(s))
+	for i, v := range s {
+		clamped[i] = Clamp(v, lo, hi)
+	}
+
+	return clamped
+}
@@ -0,0 +1,70 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestClamp(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		value    int
+		lo       int
+		hi       int
+		expected int
+	}{
+		{"Below range", -5, 0, 10, 0},
+		{"Within range", 5, 0, 10, 5},
+		{"Above range", 15, 0, 10, 10},
+		{"On lower bound", 0, 0, 10, 0},
+		{"On upper bound", 10, 0, 10, 10},
+		{"Inverted range", 5, 10, 0, 0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Clamp(scenario.value, scenario.lo, scenario.hi)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Floats and strings", func(t *testing.T) {
+		if result := Clamp(1.5, 0.0, 1.0); result != 1.0 {
+			t.Errorf("Expected result to be %v. Got %v", 1.0, result)
+		}
+
+		if result := Clamp("a", "b", "d"); result != "b" {
+			t.Errorf("Expected result to be %q. Got %q", "b", result)
+		}
+	})
+}
+
+func TestClampSlice(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Mixed values", []int{-5, 0, 3, 10, 12}, []int{0, 0, 3, 10, 10}},
+		{"All within range", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"Empty slice", []int{}, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			input := slices.Clone(scenario.input)
+			result := ClampSlice(input, 0, 10)
+
+			if !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+
+			if !slices.Equal(input, scenario.input) {
+				t.Errorf("Expected input to be unchanged. Got %#v", input)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package slices
+
+import "iter"
+
+// CollectMap drains the key/value sequence seq into a new map.
+//
+// If seq yields the same key more than once, the last value yielded for that
+// key wins.
+//
+// Example:
+//
+//	m := CollectMap(Enumerate([]string{"a", "b"}))
+//	// m == map[int]string{0: "a", 1: "b"}
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	m := make(map[K]V)
+	for k, v := range seq {
+		m[k] = v
+	}
+
+	return m
+}
+
+// CollectMapFunc drains the sequence seq into a new map, using f to turn each
+// element into a key/value pair.
+//
+// If f produces the same key more than once, the value from the latest element
+// wins.
+//
+// Example (stdslices is the standard library slices package):
+//
+//	lengths := CollectMapFunc(stdslices.Values([]string{"go", "rust"}), func(s string) (string, int) {
+//	    return s, len(s)
+//	})
+//	// lengths == map[string]int{"go": 2, "rust": 4}
+func CollectMapFunc[T any, K comparable, V any](seq iter.Seq[T], f func(T) (K, V)) map[K]V {
+	m := make(map[K]V)
+	for item := range seq {
+		k, v := f(item)
+		m[k] = v
+	}
+
+	return m
+}
@@ -0,0 +1,78 @@
+package slices
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/PsionicAlch/byteforge/datastructs/orderedmap"
+)
+
+func TestCollectMap(t *testing.T) {
+	t.Run("CollectMap from Enumerate", func(t *testing.T) {
+		result := CollectMap(Enumerate([]string{"a", "b", "c"}))
+		expected := map[int]string{0: "a", 1: "b", 2: "c"}
+
+		if !maps.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("CollectMap from OrderedMap", func(t *testing.T) {
+		m := orderedmap.New[string, int]()
+		m.Set("one", 1)
+		m.Set("two", 2)
+
+		result := CollectMap(m.Iter())
+		expected := map[string]int{"one": 1, "two": 2}
+
+		if !maps.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("CollectMap keeps the last value for duplicate keys", func(t *testing.T) {
+		seq := func(yield func(string, int) bool) {
+			_ = yield("a", 1) && yield("b", 2) && yield("a", 3)
+		}
+
+		result := CollectMap(seq)
+		expected := map[string]int{"a": 3, "b": 2}
+
+		if !maps.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("CollectMap from empty sequence", func(t *testing.T) {
+		result := CollectMap(Enumerate([]int{}))
+
+		if result == nil || len(result) != 0 {
+			t.Errorf("Expected result to be an empty map. Got %#v", result)
+		}
+	})
+}
+
+func TestCollectMapFunc(t *testing.T) {
+	t.Run("CollectMapFunc from slice values", func(t *testing.T) {
+		result := CollectMapFunc(slices.Values([]string{"go", "rust"}), func(s string) (string, int) {
+			return s, len(s)
+		})
+		expected := map[string]int{"go": 2, "rust": 4}
+
+		if !maps.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("CollectMapFunc keeps the last value for duplicate keys", func(t *testing.T) {
+		result := CollectMapFunc(slices.Values([]int{1, 2, 3, 4}), func(n int) (bool, int) {
+			return n%2 == 0, n
+		})
+		expected := map[bool]int{false: 3, true: 4}
+
+		if !maps.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
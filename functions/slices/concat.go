@@ -0,0 +1,26 @@
+package slices
+
+// Concat joins the given slices into one newly allocated slice, in the order
+// they are passed. The result is sized to the total length up front, so it is
+// grown only once no matter how many slices are joined.
+//
+// Nil and empty slices contribute no elements. The result never shares memory
+// with any of the inputs.
+//
+// Example:
+//
+//	all := Concat([]int{1, 2}, nil, []int{3}, []int{4, 5})
+//	// all == []int{1, 2, 3, 4, 5}
+func Concat[T any, S ~[]T](slices ...S) S {
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+
+	result := make(S, 0, total)
+	for _, s := range slices {
+		result = append(result, s...)
+	}
+
+	return result
+}
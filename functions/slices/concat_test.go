@@ -0,0 +1,61 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	t.Run("Concat three slices", func(t *testing.T) {
+		result := Concat([]int{1, 2}, []int{3}, []int{4, 5, 6})
+		expected := []int{1, 2, 3, 4, 5, 6}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+
+		if cap(result) != len(expected) {
+			t.Errorf("Expected capacity to be %d. Got %d", len(expected), cap(result))
+		}
+	})
+
+	t.Run("Concat with nil and empty slices", func(t *testing.T) {
+		result := Concat(nil, []string{"a"}, []string{}, nil, []string{"b"})
+		expected := []string{"a", "b"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("Concat with no slices", func(t *testing.T) {
+		result := Concat[int, []int]()
+
+		if result == nil || len(result) != 0 {
+			t.Errorf("Expected result to be an empty slice. Got %#v", result)
+		}
+	})
+
+	t.Run("Concat result is independent of the inputs", func(t *testing.T) {
+		a := []int{1, 2}
+		b := []int{3, 4}
+
+		result := Concat(a, b)
+		result[0] = 100
+		result[3] = 400
+
+		if !slices.Equal(a, []int{1, 2}) || !slices.Equal(b, []int{3, 4}) {
+			t.Errorf("Expected inputs to be unchanged. Got %#v and %#v", a, b)
+		}
+	})
+
+	t.Run("Concat preserves named slice types", func(t *testing.T) {
+		type ids []int
+
+		var result ids = Concat(ids{1}, ids{2})
+
+		if !slices.Equal(result, ids{1, 2}) {
+			t.Errorf("Expected result to be %#v. Got %#v", ids{1, 2}, result)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package slices
+
+// Count returns the number of elements in the input slice s for which the
+// predicate function f returns true.
+//
+// Unlike filtering and taking the length of the result, Count doesn't allocate.
+//
+// Example:
+//
+//	evens := Count([]int{1, 2, 3, 4}, func(n int) bool {
+//	    return n%2 == 0
+//	})
+//	// evens == 2
+func Count[T any, S ~[]T](s S, f func(T) bool) int {
+	count := 0
+	for _, v := range s {
+		if f(v) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// CountDistinct returns the number of unique values in the input slice s.
+//
+// Example:
+//
+//	n := CountDistinct([]string{"a", "b", "a"})
+//	// n == 2
+func CountDistinct[T comparable, S ~[]T](s S) int {
+	seen := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		seen[v] = struct{}{}
+	}
+
+	return len(seen)
+}
+
+// AllUnique reports whether the input slice s contains no duplicate values.
+// It stops at the first repeated value, so it is cheaper than comparing
+// CountDistinct(s) with len(s) when a duplicate appears early.
+//
+// Example:
+//
+//	ok := AllUnique([]int{1, 2, 1, 3})
+//	// ok == false
+func AllUnique[T comparable, S ~[]T](s S) bool {
+	seen := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+
+		seen[v] = struct{}{}
+	}
+
+	return true
+}
+
+// Mode returns the most frequent value in the input slice s along with the
+// number of times it appears. ok is false if s is empty.
+//
+// If several values share the highest frequency, the one that appears first
+// in s is returned.
+//
+// Example:
+//
+//	value, count, ok := Mode([]int{3, 1, 1, 3, 2})
+//	// value == 3, count == 2, ok == true
+func Mode[T comparable, S ~[]T](s S) (T, int, bool) {
+	var mode T
+	if len(s) == 0 {
+		return mode, 0, false
+	}
+
+	frequencies := make(map[T]int, len(s))
+	for _, v := range s {
+		frequencies[v]++
+	}
+
+	best := 0
+	for _, v := range s {
+		if frequencies[v] > best {
+			mode, best = v, frequencies[v]
+		}
+	}
+
+	return mode, best, true
+}
@@ -0,0 +1,110 @@
+package slices
+
+import (
+	"testing"
+
+	islices "github.com/PsionicAlch/byteforge/internal/functions/slices"
+)
+
+func TestCount(t *testing.T) {
+	isEven := func(num int) bool {
+		return num%2 == 0
+	}
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected int
+	}{
+		{"All match", []int{2, 4, 6, 8}, 4},
+		{"None match", []int{1, 3, 5, 7}, 0},
+		{"Some match", islices.IRange(1, 10), 5},
+		{"Empty slice", []int{}, 0},
+		{"Nil slice", nil, 0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Count(scenario.input, isEven)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestCountDistinct(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []string
+		expected int
+	}{
+		{"All unique", []string{"a", "b", "c"}, 3},
+		{"With duplicates", []string{"a", "b", "a", "c", "b"}, 3},
+		{"All the same", []string{"a", "a", "a"}, 1},
+		{"Empty slice", []string{}, 0},
+		{"Nil slice", nil, 0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := CountDistinct(scenario.input)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestAllUnique(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected bool
+	}{
+		{"All unique", []int{1, 2, 3, 4}, true},
+		{"Early duplicate", []int{1, 1, 2, 3, 4}, false},
+		{"Late duplicate", []int{1, 2, 3, 4, 1}, false},
+		{"Single element", []int{1}, true},
+		{"Empty slice", []int{}, true},
+		{"Nil slice", nil, true},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := AllUnique(scenario.input)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %t. Got %t", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestMode(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		input         []int
+		expectedValue int
+		expectedCount int
+		expectedOk    bool
+	}{
+		{"Clear mode", []int{1, 2, 2, 3, 2}, 2, 3, true},
+		{"Tie broken by first appearance", []int{3, 1, 1, 3, 2}, 3, 2, true},
+		{"Tie reached later by first value", []int{4, 5, 5, 4}, 4, 2, true},
+		{"Single element", []int{7}, 7, 1, true},
+		{"Empty slice", []int{}, 0, 0, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			value, count, ok := Mode(scenario.input)
+
+			if value != scenario.expectedValue || count != scenario.expectedCount || ok != scenario.expectedOk {
+				t.Errorf("Expected result to be (%d, %d, %t). Got (%d, %d, %t)", scenario.expectedValue, scenario.expectedCount, scenario.expectedOk, value, count, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package slices
+
+import "iter"
+
+// Enumerate returns an iterator that yields each index of the input slice s
+// along with the element at that index, in order. It lets callers range over
+// a slice the same way they range over other iter.Seq2 sources.
+//
+// Example:
+//
+//	for i, name := range Enumerate(names) {
+//	    fmt.Println(i, name)
+//	}
+func Enumerate[T any, S ~[]T](s S) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// ToIndexMap returns a map from each index of the input slice s to the element
+// at that index.
+//
+// Example:
+//
+//	m := ToIndexMap([]string{"a", "b"})
+//	// m == map[int]string{0: "a", 1: "b"}
+func ToIndexMap[T any, S ~[]T](s S) map[int]T {
+	m := make(map[int]T, len(s))
+	for i, v := range s {
+		m[i] = v
+	}
+
+	return m
+}
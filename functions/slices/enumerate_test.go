@@ -0,0 +1,80 @@
+package slices
+
+import (
+	"maps"
+	"reflect"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	scenarios := []struct {
+		name  string
+		input []string
+	}{
+		{"Multiple elements", []string{"a", "b", "c"}},
+		{"Single element", []string{"a"}},
+		{"Empty slice", []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var indices []int
+			var values []string
+
+			for i, v := range Enumerate(scenario.input) {
+				indices = append(indices, i)
+				values = append(values, v)
+			}
+
+			if len(indices) != len(scenario.input) {
+				t.Fatalf("Expected %d pairs. Got %d", len(scenario.input), len(indices))
+			}
+
+			for i := range scenario.input {
+				if indices[i] != i || values[i] != scenario.input[i] {
+					t.Errorf("Expected pair (%d, %q). Got (%d, %q)", i, scenario.input[i], indices[i], values[i])
+				}
+			}
+		})
+	}
+
+	t.Run("Stops early", func(t *testing.T) {
+		count := 0
+		for i := range Enumerate([]int{1, 2, 3, 4}) {
+			count++
+			if i == 1 {
+				break
+			}
+		}
+
+		if count != 2 {
+			t.Errorf("Expected 2 iterations. Got %d", count)
+		}
+	})
+}
+
+func TestToIndexMap(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []string
+		expected map[int]string
+	}{
+		{"Multiple elements", []string{"a", "b", "a"}, map[int]string{0: "a", 1: "b", 2: "a"}},
+		{"Empty slice", []string{}, map[int]string{}},
+		{"Nil slice", nil, map[int]string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := ToIndexMap(scenario.input)
+
+			if !reflect.DeepEqual(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+
+			if !maps.Equal(result, maps.Collect(Enumerate(scenario.input))) {
+				t.Errorf("Expected result to match Enumerate. Got %#v", result)
+			}
+		})
+	}
+}
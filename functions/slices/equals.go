@@ -34,6 +34,36 @@ func ShallowEquals[T comparable, A ~[]T](s1, s2 A) bool {
 	return true
 }
 
+// EqualUnorderedFunc checks to make sure that both slices contain the
+// same elements, using eq to decide whether two elements are equal. The
+// ordering of the elements doesn't matter but their frequencies do.
+//
+// Each element of s1 is matched against a not yet matched element of s2,
+// and matched elements are removed from consideration. This costs O(n²)
+// calls to eq, so prefer ShallowEquals when T is comparable.
+func EqualUnorderedFunc[T any, A ~[]T](s1, s2 A, eq func(a, b T) bool) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	remaining := slices.Clone(s2)
+
+	for _, item := range s1 {
+		index := slices.IndexFunc(remaining, func(other T) bool {
+			return eq(item, other)
+		})
+
+		if index < 0 {
+			return false
+		}
+
+		remaining[index] = remaining[len(remaining)-1]
+		remaining = remaining[:len(remaining)-1]
+	}
+
+	return true
+}
+
 // DeepEquals checks to make sure that both slices contain the
 // same elements. The ordering of the elements matter.
 func DeepEquals[T comparable, A ~[]T](s1, s2 A) bool {
@@ -189,3 +189,76 @@ func TestDeepEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestEqualUnorderedFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	sameID := func(a, b user) bool { return a.ID == b.ID }
+
+	tests := []struct {
+		name     string
+		s1       []user
+		s2       []user
+		expected bool
+	}{
+		{
+			name:     "Empty slices",
+			s1:       []user{},
+			s2:       []user{},
+			expected: true,
+		},
+		{
+			name:     "Same elements different order",
+			s1:       []user{{1, "a"}, {2, "b"}, {3, "c"}},
+			s2:       []user{{3, "c"}, {1, "a"}, {2, "b"}},
+			expected: true,
+		},
+		{
+			name:     "Equal by comparator only",
+			s1:       []user{{1, "a"}, {2, "b"}},
+			s2:       []user{{2, "B"}, {1, "A"}},
+			expected: true,
+		},
+		{
+			name:     "Different lengths",
+			s1:       []user{{1, "a"}},
+			s2:       []user{{1, "a"}, {1, "a"}},
+			expected: false,
+		},
+		{
+			name:     "Different frequencies",
+			s1:       []user{{1, "a"}, {1, "a"}, {2, "b"}},
+			s2:       []user{{1, "a"}, {2, "b"}, {2, "b"}},
+			expected: false,
+		},
+		{
+			name:     "Different elements",
+			s1:       []user{{1, "a"}, {2, "b"}},
+			s2:       []user{{1, "a"}, {3, "c"}},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := EqualUnorderedFunc(test.s1, test.s2, sameID)
+			if result != test.expected {
+				t.Errorf("EqualUnorderedFunc() = %v, want %v for %v and %v", result, test.expected, test.s1, test.s2)
+			}
+		})
+	}
+
+	t.Run("Source slices are unchanged", func(t *testing.T) {
+		s1 := []user{{1, "a"}, {2, "b"}}
+		s2 := []user{{2, "b"}, {1, "a"}}
+
+		EqualUnorderedFunc(s1, s2, sameID)
+
+		if s2[0].ID != 2 || s2[1].ID != 1 {
+			t.Errorf("Expected s2 to be unchanged. Got %#v", s2)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package slices
+
+// FindLast returns the last element in the input slice s for which the
+// predicate function f returns true. The slice is scanned from the end, so
+// FindLast stops early when the match is near the back.
+//
+// If no element matches, FindLast returns the zero value of T and false.
+//
+// Example:
+//
+//	last, ok := FindLast([]int{1, 2, 3, 4}, func(n int) bool {
+//	    return n%2 == 1
+//	})
+//	// last == 3, ok == true
+func FindLast[T any, S ~[]T](s S, f func(T) bool) (T, bool) {
+	if i := FindLastIndex(s, f); i >= 0 {
+		return s[i], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindLastIndex returns the index of the last element in the input slice s
+// for which the predicate function f returns true, or -1 if no element matches.
+//
+// Example:
+//
+//	i := FindLastIndex([]string{"a", "b", "a"}, func(s string) bool {
+//	    return s == "a"
+//	})
+//	// i == 2
+func FindLastIndex[T any, S ~[]T](s S, f func(T) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(s[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
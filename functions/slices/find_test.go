@@ -0,0 +1,74 @@
+package slices
+
+import "testing"
+
+func TestFindLast(t *testing.T) {
+	isEven := func(num int) bool {
+		return num%2 == 0
+	}
+
+	scenarios := []struct {
+		name          string
+		input         []int
+		expected      int
+		expectedFound bool
+	}{
+		{"Match at the end", []int{1, 2, 3, 4}, 4, true},
+		{"Match in the middle", []int{1, 2, 4, 5, 7}, 4, true},
+		{"Match at the start", []int{2, 3, 5}, 2, true},
+		{"No match", []int{1, 3, 5}, 0, false},
+		{"Empty slice", []int{}, 0, false},
+		{"Nil slice", nil, 0, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result, found := FindLast(scenario.input, isEven)
+
+			if result != scenario.expected || found != scenario.expectedFound {
+				t.Errorf("Expected result to be (%d, %v). Got (%d, %v)", scenario.expected, scenario.expectedFound, result, found)
+			}
+		})
+	}
+}
+
+func TestFindLastIndex(t *testing.T) {
+	isEven := func(num int) bool {
+		return num%2 == 0
+	}
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected int
+	}{
+		{"Match at the end", []int{1, 2, 3, 4}, 3},
+		{"Match in the middle", []int{1, 2, 4, 5, 7}, 2},
+		{"Match at the start", []int{2, 3, 5}, 0},
+		{"No match", []int{1, 3, 5}, -1},
+		{"Empty slice", []int{}, -1},
+		{"Nil slice", nil, -1},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := FindLastIndex(scenario.input, isEven)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestFindLastIndex_ScansFromEnd(t *testing.T) {
+	calls := 0
+	FindLastIndex([]int{1, 2, 3, 4, 5}, func(n int) bool {
+		calls++
+		return n == 5
+	})
+
+	if calls != 1 {
+		t.Errorf("Expected predicate to be called once. Got %d", calls)
+	}
+}
@@ -0,0 +1,41 @@
+package slices
+
+// FlatMap applies the given function f to each element of the input slice s
+// and concatenates the returned slices into a single slice, in order.
+//
+// f may return an empty or nil slice, in which case the element contributes
+// nothing to the result.
+//
+// Example:
+//
+//	words := FlatMap([]string{"a b", "", "c"}, func(line string) []string {
+//	    return strings.Fields(line)
+//	})
+//	// words = []string{"a", "b", "c"}
+func FlatMap[T any, R any, S ~[]T](s S, f func(T) []R) []R {
+	result := make([]R, 0, len(s))
+	for _, v := range s {
+		result = append(result, f(v)...)
+	}
+
+	return result
+}
+
+// FlatMapIndexed applies the given function f to each element of the input
+// slice s along with its index and concatenates the returned slices into a
+// single slice, in order.
+//
+// Example:
+//
+//	numbered := FlatMapIndexed([]string{"a", "b"}, func(i int, s string) []string {
+//	    return []string{strconv.Itoa(i), s}
+//	})
+//	// numbered = []string{"0", "a", "1", "b"}
+func FlatMapIndexed[T any, R any, S ~[]T](s S, f func(int, T) []R) []R {
+	result := make([]R, 0, len(s))
+	for i, v := range s {
+		result = append(result, f(i, v)...)
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+package slices
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	// repeat expands n into n copies of itself, so 0 contributes nothing.
+	repeat := func(n int) []int {
+		result := make([]int, n)
+		for i := range result {
+			result[i] = n
+		}
+		return result
+	}
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Variable lengths", []int{1, 2, 3}, []int{1, 2, 2, 3, 3, 3}},
+		{"Empty results contribute nothing", []int{0, 2, 0, 1}, []int{2, 2, 1}},
+		{"All empty results", []int{0, 0}, []int{}},
+		{"Empty slice", []int{}, []int{}},
+		{"Nil slice", nil, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := FlatMap(scenario.input, repeat)
+
+			if result == nil || !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Nil results", func(t *testing.T) {
+		result := FlatMap([]string{"a", "b"}, func(s string) []string {
+			return nil
+		})
+
+		if result == nil || len(result) != 0 {
+			t.Errorf("Expected result to be %#v. Got %#v", []string{}, result)
+		}
+	})
+}
+
+func TestFlatMapIndexed(t *testing.T) {
+	t.Run("Indices are supplied in order", func(t *testing.T) {
+		result := FlatMapIndexed([]string{"a", "b", "c"}, func(i int, s string) []string {
+			return []string{strconv.Itoa(i), s}
+		})
+		expected := []string{"0", "a", "1", "b", "2", "c"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("Empty results contribute nothing", func(t *testing.T) {
+		result := FlatMapIndexed([]string{"a", "b", "c"}, func(i int, s string) []string {
+			if i%2 == 1 {
+				return []string{}
+			}
+			return []string{s, s}
+		})
+		expected := []string{"a", "a", "c", "c"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package slices
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/PsionicAlch/byteforge/datastructs/tuple"
+)
+
+// GroupBy groups the elements of the input slice s by the key returned from
+// the function f. Each bucket keeps its elements in their original order.
+//
+// Example:
+//
+//	byParity := GroupBy([]int{1, 2, 3, 4}, func(n int) bool {
+//	    return n%2 == 0
+//	})
+//	// byParity = map[bool][]int{false: {1, 3}, true: {2, 4}}
+func GroupBy[T any, K comparable, S ~[]T](s S, f func(T) K) map[K]S {
+	groups := make(map[K]S)
+	for _, v := range s {
+		key := f(v)
+		groups[key] = append(groups[key], v)
+	}
+
+	return groups
+}
+
+// ParallelGroupBy groups the elements of the input slice s by the key returned
+// from the function f, computing the keys concurrently.
+//
+// The slice is split into contiguous ranges, one per worker, and each worker
+// builds its own partial map. The partial maps are then merged in the order of
+// their ranges, so every bucket keeps its elements in their original order and
+// the result is identical to GroupBy.
+//
+// The number of concurrent workers can be controlled via the optional
+// workers parameter. If omitted or set to a non-positive number,
+// the number of logical CPUs (runtime.GOMAXPROCS(0)) is used by default.
+//
+// Example:
+//
+//	byLength := ParallelGroupBy(words, func(w string) int {
+//	    return len(w)
+//	}, 8)
+//
+// Notes:
+// - This function is safe for functions f that are side-effect free or thread-safe.
+//
+// Panics if f panics; it does not recover from errors within goroutines.
+func ParallelGroupBy[T any, K comparable, S ~[]T](s S, f func(T) K, workers ...int) map[K]S {
+	if len(s) == 0 {
+		return map[K]S{}
+	}
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		workerCount = workers[0]
+	}
+	workerCount = min(workerCount, len(s))
+
+	chunkSize := (len(s) + workerCount - 1) / workerCount
+	partials := make([]map[K]S, (len(s)+chunkSize-1)/chunkSize)
+
+	var wg sync.WaitGroup
+
+	for i := range partials {
+		start := i * chunkSize
+		end := min(start+chunkSize, len(s))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			partials[i] = GroupBy(s[start:end], f)
+		}()
+	}
+
+	wg.Wait()
+
+	groups := partials[0]
+	for _, partial := range partials[1:] {
+		for key, bucket := range partial {
+			groups[key] = append(groups[key], bucket...)
+		}
+	}
+
+	return groups
+}
+
+// GroupConsecutive collapses each run of consecutive equal elements of the
+// input slice s into a Pair holding the element and the length of the run,
+// producing a run-length encoding of s. Equal elements that are not adjacent
+// end up in separate Pairs.
+//
+// Example:
+//
+//	runs := GroupConsecutive([]string{"a", "a", "b", "a"})
+//	// runs = []tuple.Pair[string, int]{{First: "a", Second: 2}, {First: "b", Second: 1}, {First: "a", Second: 1}}
+func GroupConsecutive[T comparable, S ~[]T](s S) []tuple.Pair[T, int] {
+	runs := []tuple.Pair[T, int]{}
+
+	for _, v := range s {
+		if last := len(runs) - 1; last >= 0 && runs[last].First == v {
+			runs[last].Second++
+			continue
+		}
+
+		runs = append(runs, tuple.NewPair(v, 1))
+	}
+
+	return runs
+}
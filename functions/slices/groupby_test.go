@@ -0,0 +1,116 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PsionicAlch/byteforge/datastructs/tuple"
+	islices "github.com/PsionicAlch/byteforge/internal/functions/slices"
+)
+
+func TestGroupBy(t *testing.T) {
+	mod3 := func(num int) int {
+		return num % 3
+	}
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected map[int][]int
+	}{
+		{"Multiple groups", islices.IRange(1, 7), map[int][]int{0: {3, 6}, 1: {1, 4, 7}, 2: {2, 5}}},
+		{"Single group", []int{3, 6, 9}, map[int][]int{0: {3, 6, 9}}},
+		{"Empty slice", []int{}, map[int][]int{}},
+		{"Nil slice", nil, map[int][]int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := GroupBy(scenario.input, mod3)
+
+			if !reflect.DeepEqual(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestParallelGroupBy(t *testing.T) {
+	mod7 := func(num int) int {
+		return num % 7
+	}
+
+	scenarios := []struct {
+		name    string
+		input   []int
+		workers []int
+	}{
+		{"Default workers", islices.ERange(0, 1000), nil},
+		{"Single worker", islices.ERange(0, 1000), []int{1}},
+		{"Uneven split", islices.ERange(0, 1001), []int{3}},
+		{"More workers than elements", islices.ERange(0, 5), []int{16}},
+		{"Non-positive workers", islices.ERange(0, 100), []int{-1}},
+		{"Empty slice", []int{}, []int{4}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			expected := GroupBy(scenario.input, mod7)
+			result := ParallelGroupBy(scenario.input, mod7, scenario.workers...)
+
+			if !reflect.DeepEqual(result, expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+			}
+		})
+	}
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []string
+		expected []tuple.Pair[string, int]
+	}{
+		{"All equal", []string{"a", "a", "a"}, []tuple.Pair[string, int]{tuple.NewPair("a", 3)}},
+		{"All distinct", []string{"a", "b", "c"}, []tuple.Pair[string, int]{tuple.NewPair("a", 1), tuple.NewPair("b", 1), tuple.NewPair("c", 1)}},
+		{"Mixed runs", []string{"a", "a", "b", "a"}, []tuple.Pair[string, int]{tuple.NewPair("a", 2), tuple.NewPair("b", 1), tuple.NewPair("a", 1)}},
+		{"Empty slice", []string{}, []tuple.Pair[string, int]{}},
+		{"Nil slice", nil, []tuple.Pair[string, int]{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := GroupConsecutive(scenario.input)
+
+			if !reflect.DeepEqual(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func BenchmarkGroupBy(b *testing.B) {
+	data := islices.ERange(0, 1_000_000)
+	key := func(num int) int {
+		return num % 100
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		GroupBy(data, key)
+	}
+}
+
+func BenchmarkParallelGroupBy(b *testing.B) {
+	data := islices.ERange(0, 1_000_000)
+	key := func(num int) int {
+		return num % 100
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ParallelGroupBy(data, key)
+	}
+}
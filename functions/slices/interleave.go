@@ -0,0 +1,51 @@
+package slices
+
+// Interleave merges the given slices into one by taking elements from each
+// slice in turn (round-robin). Once a slice is exhausted it is skipped, so the
+// remaining elements of longer slices end up at the back of the result.
+//
+// Example:
+//
+//	merged := Interleave([]int{1, 2, 3}, []int{10}, []int{20, 21})
+//	// merged = []int{1, 10, 20, 2, 21, 3}
+func Interleave[T any, S ~[]T](sources ...S) S {
+	total, longest := 0, 0
+	for _, s := range sources {
+		total += len(s)
+		longest = max(longest, len(s))
+	}
+
+	result := make(S, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, s := range sources {
+			if i < len(s) {
+				result = append(result, s[i])
+			}
+		}
+	}
+
+	return result
+}
+
+// Interpose returns a new slice with sep inserted between every pair of
+// adjacent elements of s. Slices with fewer than two elements are returned as a copy.
+//
+// Example:
+//
+//	row := Interpose([]string{"a", "b", "c"}, "|")
+//	// row = []string{"a", "|", "b", "|", "c"}
+func Interpose[T any, S ~[]T](s S, sep T) S {
+	if len(s) == 0 {
+		return S{}
+	}
+
+	result := make(S, 0, 2*len(s)-1)
+	for i, v := range s {
+		if i > 0 {
+			result = append(result, sep)
+		}
+		result = append(result, v)
+	}
+
+	return result
+}
@@ -0,0 +1,54 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInterleave(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{"Equal lengths", [][]int{{1, 2}, {10, 20}}, []int{1, 10, 2, 20}},
+		{"Unequal lengths", [][]int{{1, 2, 3}, {10}, {20, 21}}, []int{1, 10, 20, 2, 21, 3}},
+		{"Empty source", [][]int{{1, 2}, {}, {3}}, []int{1, 3, 2}},
+		{"Single source", [][]int{{1, 2, 3}}, []int{1, 2, 3}},
+		{"No sources", nil, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Interleave(scenario.input...)
+
+			if result == nil || !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestInterpose(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{"Three elements", []string{"a", "b", "c"}, []string{"a", "|", "b", "|", "c"}},
+		{"Two elements", []string{"a", "b"}, []string{"a", "|", "b"}},
+		{"Single element", []string{"a"}, []string{"a"}},
+		{"Empty slice", []string{}, []string{}},
+		{"Nil slice", nil, []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Interpose(scenario.input, "|")
+
+			if result == nil || !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
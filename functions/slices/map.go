@@ -1,6 +1,7 @@
 package slices
 
 import (
+	"fmt"
 	"runtime"
 	"sync"
 )
@@ -25,6 +26,66 @@ func Map[T any, R any, S ~[]T](s S, f func(T) R) []R {
 	return result
 }
 
+// MapIndexed applies the given function f to each element of the input slice s
+// along with its index, returning a new slice containing the results.
+//
+// It preserves the order of the original slice and runs sequentially.
+//
+// Example:
+//
+//	lines := MapIndexed([]string{"a", "b"}, func(i int, s string) string {
+//	    return fmt.Sprintf("%d: %s", i+1, s)
+//	})
+//	// lines = []string{"1: a", "2: b"}
+func MapIndexed[T any, R any, S ~[]T](s S, f func(int, T) R) []R {
+	result := make([]R, len(s))
+	for i, v := range s {
+		result[i] = f(i, v)
+	}
+
+	return result
+}
+
+// SafeMap applies the given function f to each element of the input slice s,
+// recovering from any panic raised by f so that a single bad element does not
+// abort the whole run.
+//
+// It returns the results alongside a slice of errors of the same length. For
+// each index where f panicked, the result is left as the zero value of R and
+// the error slot holds the recovered panic. Error slots for elements that
+// succeeded are nil. It preserves the order of the original slice and runs
+// sequentially.
+//
+// Example:
+//
+//	results, errs := SafeMap([]int{1, 0, 2}, func(n int) int {
+//	    return 10 / n
+//	})
+//	// results = []int{10, 0, 5}
+//	// errs[1] != nil
+func SafeMap[T any, R any, S ~[]T](s S, f func(T) R) ([]R, []error) {
+	results := make([]R, len(s))
+	errs := make([]error, len(s))
+
+	for i, v := range s {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					if err, ok := r.(error); ok {
+						errs[i] = fmt.Errorf("SafeMap() recovered panic at index %d: %w", i, err)
+					} else {
+						errs[i] = fmt.Errorf("SafeMap() recovered panic at index %d: %v", i, r)
+					}
+				}
+			}()
+
+			results[i] = f(v)
+		}()
+	}
+
+	return results, errs
+}
+
 // ParallelMap applies the function f to each element of the input slice s
 // concurrently using a worker pool, and returns a new slice containing
 // the results in the original order.
@@ -46,6 +107,30 @@ func Map[T any, R any, S ~[]T](s S, f func(T) R) []R {
 //
 // Panics if f panics; it does not recover from errors within goroutines.
 func ParallelMap[T any, R any, S ~[]T](s S, f func(T) R, workers ...int) []R {
+	return ParallelMapIndexed(s, func(_ int, v T) R {
+		return f(v)
+	}, workers...)
+}
+
+// ParallelMapIndexed applies the function f to each element of the input slice s
+// along with its index concurrently using a worker pool, and returns a new slice
+// containing the results in the original order.
+//
+// The number of concurrent workers can be controlled via the optional
+// workers parameter. If omitted or set to a non-positive number,
+// the number of logical CPUs (runtime.GOMAXPROCS(0)) is used by default.
+//
+// Example:
+//
+//	lines := ParallelMapIndexed(rows, func(i int, row string) string {
+//	    return fmt.Sprintf("%d: %s", i+1, row)
+//	}, 8)
+//
+// Notes:
+// - This function is safe for functions f that are side-effect free or thread-safe.
+//
+// Panics if f panics; it does not recover from errors within goroutines.
+func ParallelMapIndexed[T any, R any, S ~[]T](s S, f func(int, T) R, workers ...int) []R {
 	type result struct {
 		index int
 		value R
@@ -77,7 +162,7 @@ func ParallelMap[T any, R any, S ~[]T](s S, f func(T) R, workers ...int) []R {
 		go func() {
 			defer wg.Done()
 			for index := range jobs {
-				results <- result{index, f(s[index])}
+				results <- result{index, f(index, s[index])}
 			}
 		}()
 	}
@@ -94,3 +179,125 @@ func ParallelMap[T any, R any, S ~[]T](s S, f func(T) R, workers ...int) []R {
 
 	return items
 }
+
+// ParallelMapChunked applies the function f to each element of the input slice s
+// concurrently, handing each worker contiguous chunks of chunkSize elements
+// instead of individual indices. Results are written directly into a
+// preallocated slice at the chunk's offset, so they keep their original order.
+//
+// Sending one job per chunk rather than one per element makes this noticeably
+// cheaper than ParallelMap when f is cheap and s is large. If chunkSize is not
+// positive, s is split evenly across the workers.
+//
+// The number of concurrent workers can be controlled via the optional
+// workers parameter. If omitted or set to a non-positive number,
+// the number of logical CPUs (runtime.GOMAXPROCS(0)) is used by default.
+//
+// Example:
+//
+//	doubled := ParallelMapChunked(nums, func(n int) int {
+//	    return n * 2
+//	}, 4096)
+//
+// Panics if f panics; it does not recover from errors within goroutines.
+func ParallelMapChunked[T any, R any, S ~[]T](s S, f func(T) R, chunkSize int, workers ...int) []R {
+	items := make([]R, len(s))
+
+	if len(s) == 0 {
+		return items
+	}
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		workerCount = workers[0]
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = (len(s) + workerCount - 1) / workerCount
+	}
+
+	jobs := make(chan int, workerCount)
+	go func() {
+		for start := 0; start < len(s); start += chunkSize {
+			jobs <- start
+		}
+		close(jobs)
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range jobs {
+				end := min(start+chunkSize, len(s))
+				for index := start; index < end; index++ {
+					items[index] = f(s[index])
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return items
+}
+
+// ParallelMapStream applies the function f to each element of the input slice s
+// concurrently using a worker pool and passes every result to sink along with
+// the index of the element it came from, instead of collecting the results in a
+// slice. This keeps memory usage bounded when the results are large and can be
+// written out incrementally, for example to disk or over the network.
+//
+// sink is called as soon as each result is ready, so results arrive in no
+// particular order and sink may be called concurrently from several workers.
+// sink must therefore be thread-safe. ParallelMapStream returns once every
+// result has been passed to sink.
+//
+// The number of concurrent workers can be controlled via the optional
+// workers parameter. If omitted or set to a non-positive number,
+// the number of logical CPUs (runtime.GOMAXPROCS(0)) is used by default.
+//
+// Example:
+//
+//	var mu sync.Mutex
+//	ParallelMapStream(files, render, func(i int, page []byte) {
+//	    mu.Lock()
+//	    defer mu.Unlock()
+//	    archive.Write(i, page)
+//	}, 8)
+//
+// Panics if f or sink panics; it does not recover from errors within goroutines.
+func ParallelMapStream[T any, R any, S ~[]T](s S, f func(T) R, sink func(int, R), workers ...int) {
+	if len(s) == 0 {
+		return
+	}
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		workerCount = workers[0]
+	}
+
+	jobs := make(chan int, workerCount)
+	go func() {
+		for i := 0; i < len(s); i++ {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				sink(index, f(s[index]))
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -3,6 +3,8 @@ package slices
 import (
 	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	islices "github.com/PsionicAlch/byteforge/internal/functions/slices"
@@ -61,6 +63,81 @@ func TestMap(t *testing.T) {
 	})
 }
 
+func TestSafeMap(t *testing.T) {
+	t.Run("SafeMap without panics", func(t *testing.T) {
+		results, errs := SafeMap([]int{1, 2, 3}, func(num int) int {
+			return num * 2
+		})
+		expected := []int{2, 4, 6}
+
+		if !slices.Equal(results, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, results)
+		}
+
+		for i, err := range errs {
+			if err != nil {
+				t.Errorf("Expected error at index %d to be nil. Got %v", i, err)
+			}
+		}
+	})
+
+	t.Run("SafeMap recovers from a panicking element", func(t *testing.T) {
+		results, errs := SafeMap([]int{1, 0, 2, 5}, func(num int) int {
+			return 10 / num
+		})
+		expected := []int{10, 0, 5, 2}
+
+		if !slices.Equal(results, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, results)
+		}
+
+		if len(errs) != 4 {
+			t.Fatalf("Expected 4 error slots. Got %d", len(errs))
+		}
+
+		if errs[1] == nil {
+			t.Errorf("Expected error at index 1 to be populated")
+		} else if !strings.Contains(errs[1].Error(), "index 1") {
+			t.Errorf("Expected error to mention index 1. Got %q", errs[1].Error())
+		}
+
+		for _, i := range []int{0, 2, 3} {
+			if errs[i] != nil {
+				t.Errorf("Expected error at index %d to be nil. Got %v", i, errs[i])
+			}
+		}
+	})
+
+	t.Run("SafeMap recovers from non-error panic values", func(t *testing.T) {
+		results, errs := SafeMap([]string{"a", "bad", "c"}, func(s string) string {
+			if s == "bad" {
+				panic("bad element")
+			}
+
+			return strings.ToUpper(s)
+		})
+		expected := []string{"A", "", "C"}
+
+		if !slices.Equal(results, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, results)
+		}
+
+		if errs[1] == nil || !strings.Contains(errs[1].Error(), "bad element") {
+			t.Errorf("Expected error at index 1 to contain %q. Got %v", "bad element", errs[1])
+		}
+	})
+
+	t.Run("SafeMap with empty slice", func(t *testing.T) {
+		results, errs := SafeMap([]int{}, func(num int) int {
+			return num
+		})
+
+		if len(results) != 0 || len(errs) != 0 {
+			t.Errorf("Expected empty results and errors. Got %#v and %#v", results, errs)
+		}
+	})
+}
+
 func TestParallelMap(t *testing.T) {
 	const max = 1000000
 	largeArr := islices.ERange(0, max)
@@ -134,3 +211,190 @@ func TestParallelMap(t *testing.T) {
 		}
 	})
 }
+
+func TestParallelMapChunked(t *testing.T) {
+	const max = 1000000
+	largeArr := islices.ERange(0, max)
+
+	largeExpected := make([]int, max)
+	for i := 0; i < max; i++ {
+		largeExpected[i] = i * 2
+	}
+
+	double := func(num int) int {
+		return num * 2
+	}
+
+	scenarios := []struct {
+		name      string
+		input     []int
+		chunkSize int
+		workers   []int
+		expected  []int
+	}{
+		{"Empty slice", []int{}, 4, nil, []int{}},
+		{"Chunk smaller than slice", []int{0, 1, 2, 3, 4}, 2, nil, []int{0, 2, 4, 6, 8}},
+		{"Chunk larger than slice", []int{0, 1, 2}, 10, nil, []int{0, 2, 4}},
+		{"Non-positive chunk size", []int{0, 1, 2, 3}, 0, []int{3}, []int{0, 2, 4, 6}},
+		{"Negative worker pool", []int{0, 1, 2}, 1, []int{-10}, []int{0, 2, 4}},
+		{"Huge slice", largeArr, 4096, nil, largeExpected},
+		{"Huge slice with positive worker pool", largeArr, 1000, []int{50}, largeExpected},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := ParallelMapChunked(scenario.input, double, scenario.chunkSize, scenario.workers...)
+
+			if !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Parallel map chunked from int to string", func(t *testing.T) {
+		result := ParallelMapChunked([]int{0, 1, 2, 3}, strconv.Itoa, 3)
+		expected := []string{"0", "1", "2", "3"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
+
+func TestMapIndexed(t *testing.T) {
+	t.Run("Map indexed passes indices in order", func(t *testing.T) {
+		result := MapIndexed([]string{"a", "b", "c"}, func(i int, s string) string {
+			return strconv.Itoa(i) + ":" + s
+		})
+		expected := []string{"0:a", "1:b", "2:c"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("Map indexed with empty slice", func(t *testing.T) {
+		result := MapIndexed([]int{}, func(i int, num int) int {
+			return i
+		})
+		expected := []int{}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
+
+func TestParallelMapIndexed(t *testing.T) {
+	const max = 100000
+	largeArr := islices.ERange(0, max)
+
+	t.Run("Parallel map indexed passes every index", func(t *testing.T) {
+		result := ParallelMapIndexed(largeArr, func(i int, num int) int {
+			return i
+		}, 8)
+
+		if len(result) != len(largeArr) {
+			t.Fatalf("Expected result length to be %d. Got %d", len(largeArr), len(result))
+		}
+
+		if !slices.Equal(result, largeArr) {
+			t.Error("Expected indices 0..n-1 to be supplied in order")
+		}
+	})
+
+	t.Run("Parallel map indexed matches sequential", func(t *testing.T) {
+		f := func(i int, s string) string {
+			return strconv.Itoa(i) + ":" + s
+		}
+		input := []string{"a", "b", "c", "d", "e"}
+
+		result := ParallelMapIndexed(input, f, -1)
+		expected := MapIndexed(input, f)
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("Parallel map indexed with empty slice", func(t *testing.T) {
+		result := ParallelMapIndexed([]int{}, func(i int, num int) int {
+			return i
+		})
+		expected := []int{}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
+
+func TestParallelMapStream(t *testing.T) {
+	const max = 10000
+	input := islices.ERange(0, max)
+	double := func(num int) int {
+		return num * 2
+	}
+
+	scenarios := []struct {
+		name    string
+		input   []int
+		workers []int
+	}{
+		{"Default workers", input, nil},
+		{"Positive worker pool", input, []int{50}},
+		{"Negative worker pool", input, []int{-10}},
+		{"Empty slice", []int{}, []int{4}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var mu sync.Mutex
+			results := make(map[int]int)
+
+			ParallelMapStream(scenario.input, double, func(i int, v int) {
+				mu.Lock()
+				defer mu.Unlock()
+				results[i] = v
+			}, scenario.workers...)
+
+			expected := ParallelMap(scenario.input, double)
+
+			if len(results) != len(expected) {
+				t.Fatalf("Expected %d results. Got %d", len(expected), len(results))
+			}
+
+			for i, v := range expected {
+				if results[i] != v {
+					t.Errorf("Expected result at index %d to be %d. Got %d", i, v, results[i])
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	data := islices.ERange(0, 10_000_000)
+	double := func(num int) int {
+		return num * 2
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ParallelMap(data, double)
+	}
+}
+
+func BenchmarkParallelMapChunked(b *testing.B) {
+	data := islices.ERange(0, 10_000_000)
+	double := func(num int) int {
+		return num * 2
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		ParallelMapChunked(data, double, 4096)
+	}
+}
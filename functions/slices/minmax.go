@@ -0,0 +1,47 @@
+package slices
+
+import "github.com/PsionicAlch/byteforge/constraints"
+
+// MaxIndex returns the index of the first largest element of the input slice s,
+// or -1 if s is empty.
+//
+// Example:
+//
+//	i := MaxIndex([]int{3, 7, 1, 7})
+//	// i == 1
+func MaxIndex[T constraints.Ordered, S ~[]T](s S) int {
+	if len(s) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] > s[index] {
+			index = i
+		}
+	}
+
+	return index
+}
+
+// MinIndex returns the index of the first smallest element of the input slice s,
+// or -1 if s is empty.
+//
+// Example:
+//
+//	i := MinIndex([]int{3, 1, 7, 1})
+//	// i == 1
+func MinIndex[T constraints.Ordered, S ~[]T](s S) int {
+	if len(s) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] < s[index] {
+			index = i
+		}
+	}
+
+	return index
+}
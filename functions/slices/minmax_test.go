@@ -0,0 +1,61 @@
+package slices
+
+import "testing"
+
+func TestMaxIndex(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		expected int
+	}{
+		{"Distinct values", []int{3, 9, 1, 4}, 1},
+		{"Ties return first", []int{3, 7, 1, 7}, 1},
+		{"Max at the end", []int{1, 2, 3}, 2},
+		{"Negative values", []int{-5, -2, -9}, 1},
+		{"Single element", []int{42}, 0},
+		{"Empty slice", []int{}, -1},
+		{"Nil slice", nil, -1},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := MaxIndex(scenario.input)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Strings", func(t *testing.T) {
+		if result := MaxIndex([]string{"b", "c", "a", "c"}); result != 1 {
+			t.Errorf("Expected result to be %d. Got %d", 1, result)
+		}
+	})
+}
+
+func TestMinIndex(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []float64
+		expected int
+	}{
+		{"Distinct values", []float64{3, 9, 1, 4}, 2},
+		{"Ties return first", []float64{3, 1, 7, 1}, 1},
+		{"Min at the start", []float64{0.5, 2, 3}, 0},
+		{"Negative values", []float64{-5, -2, -9}, 2},
+		{"Single element", []float64{42}, 0},
+		{"Empty slice", []float64{}, -1},
+		{"Nil slice", nil, -1},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := MinIndex(scenario.input)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package slices
+
+// PadRight returns a new slice holding the elements of s followed by as many
+// copies of fill as are needed to reach the given length. If s already has at
+// least length elements, a copy of s is returned unchanged.
+//
+// Example:
+//
+//	row := PadRight([]string{"a", "b"}, 4, "")
+//	// row == []string{"a", "b", "", ""}
+func PadRight[T any, S ~[]T](s S, length int, fill T) S {
+	padded := make(S, max(len(s), length))
+
+	copy(padded, s)
+	for i := len(s); i < len(padded); i++ {
+		padded[i] = fill
+	}
+
+	return padded
+}
+
+// PadLeft returns a new slice holding as many copies of fill as are needed to
+// reach the given length, followed by the elements of s. If s already has at
+// least length elements, a copy of s is returned unchanged.
+//
+// Example:
+//
+//	digits := PadLeft([]int{4, 2}, 4, 0)
+//	// digits == []int{0, 0, 4, 2}
+func PadLeft[T any, S ~[]T](s S, length int, fill T) S {
+	padded := make(S, max(len(s), length))
+
+	offset := len(padded) - len(s)
+	for i := 0; i < offset; i++ {
+		padded[i] = fill
+	}
+	copy(padded[offset:], s)
+
+	return padded
+}
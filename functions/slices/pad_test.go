@@ -0,0 +1,74 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+var padScenarios = []struct {
+	name          string
+	input         []int
+	length        int
+	expectedLeft  []int
+	expectedRight []int
+}{
+	{"Shorter than length", []int{1, 2}, 4, []int{0, 0, 1, 2}, []int{1, 2, 0, 0}},
+	{"Equal to length", []int{1, 2, 3}, 3, []int{1, 2, 3}, []int{1, 2, 3}},
+	{"Longer than length", []int{1, 2, 3}, 2, []int{1, 2, 3}, []int{1, 2, 3}},
+	{"Negative length", []int{1}, -1, []int{1}, []int{1}},
+	{"Empty slice", []int{}, 2, []int{0, 0}, []int{0, 0}},
+	{"Nil slice", nil, 1, []int{0}, []int{0}},
+}
+
+func TestPadRight(t *testing.T) {
+	for _, scenario := range padScenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			input := slices.Clone(scenario.input)
+			result := PadRight(input, scenario.length, 0)
+
+			if !slices.Equal(result, scenario.expectedRight) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expectedRight, result)
+			}
+
+			if !slices.Equal(input, scenario.input) {
+				t.Errorf("Expected input to be unchanged. Got %#v", input)
+			}
+		})
+	}
+
+	t.Run("Result does not share memory", func(t *testing.T) {
+		input := []int{1, 2}
+		result := PadRight(input, 1, 0)
+		result[0] = 100
+
+		if input[0] != 1 {
+			t.Errorf("Expected input to be unchanged. Got %#v", input)
+		}
+	})
+}
+
+func TestPadLeft(t *testing.T) {
+	for _, scenario := range padScenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			input := slices.Clone(scenario.input)
+			result := PadLeft(input, scenario.length, 0)
+
+			if !slices.Equal(result, scenario.expectedLeft) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expectedLeft, result)
+			}
+
+			if !slices.Equal(input, scenario.input) {
+				t.Errorf("Expected input to be unchanged. Got %#v", input)
+			}
+		})
+	}
+
+	t.Run("Strings", func(t *testing.T) {
+		result := PadLeft([]string{"x"}, 3, "-")
+		expected := []string{"-", "-", "x"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
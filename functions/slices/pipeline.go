@@ -0,0 +1,110 @@
+package slices
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// Pipeline runs stage over every element of the input sequence using a pool of
+// worker goroutines and returns a sequence of the results.
+//
+// Unlike ParallelMap it works on sequences of unknown length and processes them
+// as a stream, so only a handful of elements are in flight at any time. The
+// results are yielded in the order the workers finish them, which is not
+// necessarily the order of the input.
+//
+// Iteration stops when the input is exhausted, when ctx is cancelled, or when
+// the consumer stops ranging over the result. Cancelling ctx stops the iteration
+// even while in is blocked waiting for its next element. In every case the
+// workers are shut down before the iteration returns, so stage is never called
+// after that point.
+//
+// If workers is less than or equal to 0, the number of logical CPUs
+// (runtime.GOMAXPROCS(0)) is used.
+//
+// Example:
+//
+//	for page := range Pipeline(ctx, urls, fetch, 8) {
+//	    fmt.Println(page.Title)
+//	}
+//
+// Notes:
+// - stage must be safe to call from multiple goroutines.
+// - in is consumed from a separate goroutine.
+//
+// Cancellation does not wait for the goroutine consuming in. If in is blocked
+// when ctx is cancelled, that goroutine stays parked until in yields or returns,
+// and then exits without handing the element to a worker.
+//
+// Panics if stage panics; it does not recover from errors within goroutines.
+func Pipeline[T any, R any](ctx context.Context, in iter.Seq[T], stage func(T) R, workers int) iter.Seq[R] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return func(yield func(R) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+
+		jobs := make(chan T)
+		results := make(chan R)
+
+		go func() {
+			defer close(jobs)
+			for v := range in {
+				select {
+				case jobs <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					var v T
+					var ok bool
+
+					select {
+					case v, ok = <-jobs:
+						if !ok {
+							return
+						}
+					case <-ctx.Done():
+						return
+					}
+
+					select {
+					case results <- stage(v):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		// Stop the workers and wait for them to exit before returning.
+		defer func() {
+			cancel()
+			for range results {
+			}
+		}()
+
+		for r := range results {
+			if ctx.Err() != nil || !yield(r) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package slices
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	islices "github.com/PsionicAlch/byteforge/internal/functions/slices"
+)
+
+func TestPipeline(t *testing.T) {
+	double := func(num int) int {
+		return num * 2
+	}
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		workers  int
+		expected []int
+	}{
+		{"Multiple workers", islices.IRange(1, 100), 4, Map(islices.IRange(1, 100), double)},
+		{"Single worker", islices.IRange(1, 10), 1, Map(islices.IRange(1, 10), double)},
+		{"Default workers", islices.IRange(1, 10), 0, Map(islices.IRange(1, 10), double)},
+		{"Empty sequence", []int{}, 4, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := []int{}
+			for v := range Pipeline(context.Background(), slices.Values(scenario.input), double, scenario.workers) {
+				result = append(result, v)
+			}
+
+			// Output order isn't guaranteed.
+			slices.Sort(result)
+
+			if !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestPipeline_Cancellation(t *testing.T) {
+	// naturals is an endless sequence, so the pipeline can only stop through cancellation.
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int64
+	stage := func(num int) int {
+		calls.Add(1)
+		return num
+	}
+
+	count := 0
+	for range Pipeline(ctx, naturals, stage, 4) {
+		count++
+		if count == 10 {
+			cancel()
+		}
+	}
+
+	if count < 10 {
+		t.Errorf("Expected at least 10 results before cancellation. Got %d", count)
+	}
+
+	// No stage calls should happen once the iteration has returned.
+	after := calls.Load()
+	time.Sleep(10 * time.Millisecond)
+
+	if calls.Load() != after {
+		t.Errorf("Expected no stage calls after the pipeline returned. Got %d more", calls.Load()-after)
+	}
+}
+
+func TestPipeline_CancellationWithBlockedInput(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	// blocking yields a single element and then blocks until the test finishes.
+	blocking := func(yield func(int) bool) {
+		if !yield(1) {
+			return
+		}
+
+		<-release
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan int)
+	go func() {
+		count := 0
+		for range Pipeline(ctx, blocking, func(n int) int { return n }, 4) {
+			count++
+			cancel()
+		}
+		done <- count
+	}()
+
+	select {
+	case count := <-done:
+		if count != 1 {
+			t.Errorf("Expected result to be %d. Got %d", 1, count)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the pipeline to stop after cancellation while the input was blocked")
+	}
+}
+
+func TestPipeline_EarlyBreak(t *testing.T) {
+	count := 0
+	for range Pipeline(context.Background(), slices.Values(islices.IRange(1, 1000)), func(n int) int { return n }, 4) {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+
+	if count != 5 {
+		t.Errorf("Expected result to be %d. Got %d", 5, count)
+	}
+}
+
+func TestPipeline_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	count := 0
+	for range Pipeline(ctx, slices.Values(islices.IRange(1, 1000)), func(n int) int { return n }, 4) {
+		count++
+	}
+
+	if count != 0 {
+		t.Errorf("Expected result to be %d. Got %d", 0, count)
+	}
+}
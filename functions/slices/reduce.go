@@ -0,0 +1,28 @@
+package slices
+
+// ReduceWhile folds the elements of the input slice s into a single value,
+// starting from initial and calling f with the accumulator and each element in
+// order.
+//
+// f returns the new accumulator along with whether folding should continue.
+// The accumulator returned alongside false is kept, and the remaining elements
+// are skipped.
+//
+// Example:
+//
+//	sum := ReduceWhile([]int{4, 5, 6, 7}, func(acc, n int) (int, bool) {
+//	    return acc + n, acc+n <= 10
+//	}, 0)
+//	// sum == 15
+func ReduceWhile[T any, R any, S ~[]T](s S, f func(R, T) (R, bool), initial R) R {
+	acc := initial
+
+	for _, v := range s {
+		var next bool
+		if acc, next = f(acc, v); !next {
+			break
+		}
+	}
+
+	return acc
+}
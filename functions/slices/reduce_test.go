@@ -0,0 +1,55 @@
+package slices
+
+import (
+	"testing"
+)
+
+func TestReduceWhile(t *testing.T) {
+	sumUntilOverLimit := func(acc, n int) (int, bool) {
+		return acc + n, acc+n <= 10
+	}
+
+	scenarios := []struct {
+		name          string
+		input         []int
+		expected      int
+		expectedCalls int
+	}{
+		{"Stops partway", []int{4, 5, 6, 7}, 15, 3},
+		{"Stops on the first element", []int{11, 1}, 11, 1},
+		{"Runs to completion", []int{1, 2, 3}, 6, 3},
+		{"Empty slice", []int{}, 0, 0},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			calls := 0
+			result := ReduceWhile(scenario.input, func(acc, n int) (int, bool) {
+				calls++
+				return sumUntilOverLimit(acc, n)
+			}, 0)
+
+			if result != scenario.expected {
+				t.Errorf("Expected result to be %d. Got %d", scenario.expected, result)
+			}
+
+			if calls != scenario.expectedCalls {
+				t.Errorf("Expected f to be called %d times. Got %d", scenario.expectedCalls, calls)
+			}
+		})
+	}
+
+	t.Run("Different accumulator type", func(t *testing.T) {
+		result := ReduceWhile([]string{"a", "b", "", "c"}, func(acc []string, s string) ([]string, bool) {
+			if s == "" {
+				return acc, false
+			}
+
+			return append(acc, s), true
+		}, []string{})
+
+		if len(result) != 2 || result[0] != "a" || result[1] != "b" {
+			t.Errorf("Expected result to be %#v. Got %#v", []string{"a", "b"}, result)
+		}
+	})
+}
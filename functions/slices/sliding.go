@@ -0,0 +1,30 @@
+package slices
+
+// SlidingReduce applies f to every contiguous window of the given size in s,
+// moving one element at a time, and returns one result per window.
+//
+// The result holds len(s)-window+1 elements. If window is less than or equal
+// to 0 or larger than len(s), the result is empty.
+//
+// Each window is a sub-slice of s with its capacity capped to its length, so f
+// must not keep a window around and expect it to stay unchanged if s changes.
+//
+// Example:
+//
+//	averages := SlidingReduce([]float64{1, 2, 3, 4}, 3, func(w []float64) float64 {
+//	    return (w[0] + w[1] + w[2]) / 3
+//	})
+//	// averages == []float64{2, 3}
+func SlidingReduce[T any, R any, S ~[]T](s S, window int, f func(S) R) []R {
+	if window <= 0 || window > len(s) {
+		return []R{}
+	}
+
+	results := make([]R, len(s)-window+1)
+	for start := range results {
+		end := start + window
+		results[start] = f(s[start:end:end])
+	}
+
+	return results
+}
@@ -0,0 +1,65 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSlidingReduce(t *testing.T) {
+	sum := func(window []int) int {
+		total := 0
+		for _, n := range window {
+			total += n
+		}
+
+		return total
+	}
+
+	scenarios := []struct {
+		name     string
+		input    []int
+		window   int
+		expected []int
+	}{
+		{"Window of one", []int{1, 2, 3}, 1, []int{1, 2, 3}},
+		{"Window of three", []int{1, 2, 3, 4}, 3, []int{6, 9}},
+		{"Window equal to length", []int{1, 2, 3}, 3, []int{6}},
+		{"Window larger than length", []int{1, 2, 3}, 4, []int{}},
+		{"Non-positive window", []int{1, 2, 3}, 0, []int{}},
+		{"Empty slice", []int{}, 1, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := SlidingReduce(scenario.input, scenario.window, sum)
+
+			if !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Moving average", func(t *testing.T) {
+		result := SlidingReduce([]float64{1, 2, 3, 4}, 3, func(window []float64) float64 {
+			return (window[0] + window[1] + window[2]) / 3
+		})
+		expected := []float64{2, 3}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+
+	t.Run("Windows cannot overwrite each other", func(t *testing.T) {
+		input := []int{1, 2, 3}
+
+		SlidingReduce(input, 2, func(window []int) int {
+			window = append(window, 100)
+			return window[0]
+		})
+
+		if !slices.Equal(input, []int{1, 2, 3}) {
+			t.Errorf("Expected input to be unchanged. Got %#v", input)
+		}
+	})
+}
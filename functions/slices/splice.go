@@ -0,0 +1,53 @@
+package slices
+
+// Insert returns a new slice with items inserted into s at the given index.
+//
+// The index must be in the range [0, len(s)], where len(s) appends the items
+// to the end. If the index is out of range, a copy of s is returned unchanged.
+// The input slice is never modified.
+//
+// Example:
+//
+//	result := Insert([]int{1, 4}, 1, 2, 3)
+//	// result == []int{1, 2, 3, 4}
+func Insert[T any, S ~[]T](s S, index int, items ...T) S {
+	if index < 0 || index > len(s) {
+		return append(S(nil), s...)
+	}
+
+	result := make(S, 0, len(s)+len(items))
+	result = append(result, s[:index]...)
+	result = append(result, items...)
+	result = append(result, s[index:]...)
+
+	return result
+}
+
+// RemoveAt returns a new slice with count elements removed from s starting at
+// the given index.
+//
+// The index must be in the range [0, len(s)). If count extends past the end of
+// the slice, it is clamped so that every element from index onward is removed.
+// If the index is out of range or count is not positive, a copy of s is returned
+// unchanged. The input slice is never modified.
+//
+// Example:
+//
+//	result := RemoveAt([]int{1, 2, 3, 4}, 1, 2)
+//	// result == []int{1, 4}
+func RemoveAt[T any, S ~[]T](s S, index, count int) S {
+	if index < 0 || index >= len(s) || count <= 0 {
+		return append(S(nil), s...)
+	}
+
+	end := len(s)
+	if count < end-index {
+		end = index + count
+	}
+
+	result := make(S, 0, len(s)-(end-index))
+	result = append(result, s[:index]...)
+	result = append(result, s[end:]...)
+
+	return result
+}
@@ -0,0 +1,85 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInsert(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		index    int
+		items    []int
+		expected []int
+	}{
+		{"Insert at start", []int{3, 4}, 0, []int{1, 2}, []int{1, 2, 3, 4}},
+		{"Insert in middle", []int{1, 4}, 1, []int{2, 3}, []int{1, 2, 3, 4}},
+		{"Insert at end", []int{1, 2}, 2, []int{3, 4}, []int{1, 2, 3, 4}},
+		{"Insert into empty slice", []int{}, 0, []int{1}, []int{1}},
+		{"Insert nothing", []int{1, 2}, 1, []int{}, []int{1, 2}},
+		{"Insert at negative index", []int{1, 2}, -1, []int{3}, []int{1, 2}},
+		{"Insert past the end", []int{1, 2}, 3, []int{3}, []int{1, 2}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			original := slices.Clone(scenario.input)
+			result := Insert(scenario.input, scenario.index, scenario.items...)
+
+			if !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+
+			if !slices.Equal(scenario.input, original) {
+				t.Errorf("Expected input to be unchanged. Got %#v", scenario.input)
+			}
+		})
+	}
+
+	t.Run("Result is independent of input", func(t *testing.T) {
+		input := []int{1, 2, 3}
+		result := Insert(input, 5, 4)
+		result[0] = 100
+
+		if input[0] != 1 {
+			t.Error("Expected modifying the result to not affect the input")
+		}
+	})
+}
+
+func TestRemoveAt(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    []int
+		index    int
+		count    int
+		expected []int
+	}{
+		{"Remove from start", []int{1, 2, 3, 4}, 0, 2, []int{3, 4}},
+		{"Remove from middle", []int{1, 2, 3, 4}, 1, 2, []int{1, 4}},
+		{"Remove last element", []int{1, 2, 3, 4}, 3, 1, []int{1, 2, 3}},
+		{"Remove past the end is clamped", []int{1, 2, 3, 4}, 2, 10, []int{1, 2}},
+		{"Remove everything", []int{1, 2, 3, 4}, 0, 4, []int{}},
+		{"Remove zero elements", []int{1, 2, 3}, 1, 0, []int{1, 2, 3}},
+		{"Remove negative count", []int{1, 2, 3}, 1, -1, []int{1, 2, 3}},
+		{"Remove at negative index", []int{1, 2, 3}, -1, 1, []int{1, 2, 3}},
+		{"Remove at index past the end", []int{1, 2, 3}, 3, 1, []int{1, 2, 3}},
+		{"Remove from empty slice", []int{}, 0, 1, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			original := slices.Clone(scenario.input)
+			result := RemoveAt(scenario.input, scenario.index, scenario.count)
+
+			if !slices.Equal(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+
+			if !slices.Equal(scenario.input, original) {
+				t.Errorf("Expected input to be unchanged. Got %#v", scenario.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package slices
+
+import (
+	"iter"
+	"sync"
+)
+
+// Tee fans a single-pass sequence out into n independent sequences that each
+// yield every element of seq in order.
+//
+// Because seq can only be consumed once, Tee buffers it in full the first time
+// any of the returned sequences is iterated. Every element is therefore held in
+// memory until all of the returned sequences are no longer referenced, which makes
+// Tee unsuitable for unbounded sequences. The returned sequences may be consumed
+// from different goroutines and may each be iterated any number of times.
+//
+// If n is less than or equal to 0, an empty slice is returned.
+//
+// Example:
+//
+//	seqs := Tee(s.Iter(), 2)
+//	for v := range seqs[0] {
+//	    fmt.Println("first:", v)
+//	}
+//	for v := range seqs[1] {
+//	    fmt.Println("second:", v)
+//	}
+func Tee[T any](seq iter.Seq[T], n int) []iter.Seq[T] {
+	if n <= 0 {
+		return []iter.Seq[T]{}
+	}
+
+	var once sync.Once
+	var buffer []T
+
+	fill := func() {
+		for v := range seq {
+			buffer = append(buffer, v)
+		}
+	}
+
+	seqs := make([]iter.Seq[T], n)
+	for i := range seqs {
+		seqs[i] = func(yield func(T) bool) {
+			once.Do(fill)
+
+			for _, v := range buffer {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+
+	return seqs
+}
@@ -0,0 +1,97 @@
+package slices
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestTee(t *testing.T) {
+	t.Run("Tee into multiple consumers", func(t *testing.T) {
+		input := []int{1, 2, 3, 4, 5}
+		seqs := Tee(slices.Values(input), 3)
+
+		if len(seqs) != 3 {
+			t.Fatalf("Expected 3 sequences. Got %d", len(seqs))
+		}
+
+		for i, seq := range seqs {
+			result := slices.Collect(seq)
+			if !slices.Equal(result, input) {
+				t.Errorf("Expected sequence %d to yield %#v. Got %#v", i, input, result)
+			}
+		}
+	})
+
+	t.Run("Tee consumes source only once", func(t *testing.T) {
+		pulls := 0
+		var source iter.Seq[int] = func(yield func(int) bool) {
+			pulls++
+			for i := 0; i < 3; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+
+		seqs := Tee(source, 2)
+		for _, seq := range seqs {
+			for range seq {
+			}
+		}
+
+		if pulls != 1 {
+			t.Errorf("Expected source to be consumed once. Got %d", pulls)
+		}
+	})
+
+	t.Run("Tee with early break", func(t *testing.T) {
+		seqs := Tee(slices.Values([]int{1, 2, 3, 4}), 2)
+
+		var first []int
+		for v := range seqs[0] {
+			if v > 2 {
+				break
+			}
+			first = append(first, v)
+		}
+
+		if !slices.Equal(first, []int{1, 2}) {
+			t.Errorf("Expected first consumer to see %#v. Got %#v", []int{1, 2}, first)
+		}
+
+		second := slices.Collect(seqs[1])
+		if !slices.Equal(second, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected second consumer to see %#v. Got %#v", []int{1, 2, 3, 4}, second)
+		}
+	})
+
+	t.Run("Tee with concurrent consumers", func(t *testing.T) {
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i
+		}
+
+		seqs := Tee(slices.Values(input), 10)
+
+		var wg sync.WaitGroup
+		for _, seq := range seqs {
+			wg.Add(1)
+			go func(seq iter.Seq[int]) {
+				defer wg.Done()
+				if result := slices.Collect(seq); !slices.Equal(result, input) {
+					t.Error("Expected every consumer to see every element")
+				}
+			}(seq)
+		}
+
+		wg.Wait()
+	})
+
+	t.Run("Tee with non-positive n", func(t *testing.T) {
+		if seqs := Tee(slices.Values([]int{1, 2, 3}), 0); len(seqs) != 0 {
+			t.Errorf("Expected no sequences. Got %d", len(seqs))
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package slices
+
+// Transpose swaps the rows and columns of matrix, so that the i-th row of the
+// result holds the i-th element of every row of matrix.
+//
+// Ragged rows are handled the same way Zip handles slices of different
+// lengths: the result only has as many rows as the shortest row of matrix has
+// elements, and the extra cells of longer rows are dropped. An empty matrix
+// results in an empty slice.
+//
+// Example:
+//
+//	t := Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+//	// t == [][]int{{1, 4}, {2, 5}, {3, 6}}
+func Transpose[T any, S ~[]T](matrix []S) []S {
+	if len(matrix) == 0 {
+		return []S{}
+	}
+
+	columns := len(matrix[0])
+	for _, row := range matrix[1:] {
+		columns = min(columns, len(row))
+	}
+
+	transposed := make([]S, columns)
+	for c := range transposed {
+		transposed[c] = make(S, len(matrix))
+		for r, row := range matrix {
+			transposed[c][r] = row[c]
+		}
+	}
+
+	return transposed
+}
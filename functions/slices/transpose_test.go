@@ -0,0 +1,41 @@
+package slices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTranspose(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		input    [][]int
+		expected [][]int
+	}{
+		{"Square matrix", [][]int{{1, 2}, {3, 4}}, [][]int{{1, 3}, {2, 4}}},
+		{"Rectangular matrix", [][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		{"Single row", [][]int{{1, 2, 3}}, [][]int{{1}, {2}, {3}}},
+		{"Single column", [][]int{{1}, {2}, {3}}, [][]int{{1, 2, 3}}},
+		{"Ragged rows stop at the shortest row", [][]int{{1, 2, 3}, {4}, {5, 6}}, [][]int{{1, 4, 5}}},
+		{"Row without elements", [][]int{{1, 2}, {}}, [][]int{}},
+		{"Empty matrix", [][]int{}, [][]int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Transpose(scenario.input)
+
+			if !slices.EqualFunc(result, scenario.expected, slices.Equal[[]int]) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Round trip", func(t *testing.T) {
+		input := [][]string{{"a", "b", "c"}, {"d", "e", "f"}}
+		result := Transpose(Transpose(input))
+
+		if !slices.EqualFunc(result, input, slices.Equal[[]string]) {
+			t.Errorf("Expected result to be %#v. Got %#v", input, result)
+		}
+	})
+}
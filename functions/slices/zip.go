@@ -0,0 +1,60 @@
+package slices
+
+import "github.com/PsionicAlch/byteforge/datastructs/tuple"
+
+// Zip combines the elements of a and b into a slice of Pairs, where the i-th
+// Pair holds a[i] and b[i]. If the slices have different lengths, the result
+// is truncated to the length of the shorter one.
+//
+// Example:
+//
+//	pairs := Zip([]int{1, 2, 3}, []string{"a", "b"})
+//	// pairs = []tuple.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+func Zip[A any, B any, SA ~[]A, SB ~[]B](a SA, b SB) []tuple.Pair[A, B] {
+	n := min(len(a), len(b))
+
+	pairs := make([]tuple.Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		pairs[i] = tuple.NewPair(a[i], b[i])
+	}
+
+	return pairs
+}
+
+// Unzip splits a slice of Pairs into two slices of equal length, one holding
+// the first values and the other holding the second values. It reverses Zip.
+//
+// Example:
+//
+//	nums, letters := Unzip(Zip([]int{1, 2}, []string{"a", "b"}))
+//	// nums = []int{1, 2}, letters = []string{"a", "b"}
+func Unzip[A any, B any](pairs []tuple.Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+
+	for i, p := range pairs {
+		a[i] = p.First
+		b[i] = p.Second
+	}
+
+	return a, b
+}
+
+// ZipWith combines the elements of a and b by applying f to each index-aligned
+// pair, returning a slice of the results. If the slices have different
+// lengths, the result is truncated to the length of the shorter one.
+//
+// Example:
+//
+//	sums := ZipWith([]int{1, 2, 3}, []int{10, 20, 30}, func(x, y int) int { return x + y })
+//	// sums = []int{11, 22, 33}
+func ZipWith[A any, B any, R any, SA ~[]A, SB ~[]B](a SA, b SB, f func(A, B) R) []R {
+	n := min(len(a), len(b))
+
+	result := make([]R, n)
+	for i := 0; i < n; i++ {
+		result[i] = f(a[i], b[i])
+	}
+
+	return result
+}
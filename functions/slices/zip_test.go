@@ -0,0 +1,110 @@
+package slices
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/PsionicAlch/byteforge/datastructs/tuple"
+)
+
+func TestZip(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		a        []int
+		b        []string
+		expected []tuple.Pair[int, string]
+	}{
+		{"Equal lengths", []int{1, 2}, []string{"a", "b"}, []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")}},
+		{"First shorter", []int{1}, []string{"a", "b"}, []tuple.Pair[int, string]{tuple.NewPair(1, "a")}},
+		{"Second shorter", []int{1, 2, 3}, []string{"a"}, []tuple.Pair[int, string]{tuple.NewPair(1, "a")}},
+		{"Empty slice", []int{}, []string{"a"}, []tuple.Pair[int, string]{}},
+		{"Nil slices", nil, nil, []tuple.Pair[int, string]{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := Zip(scenario.a, scenario.b)
+
+			if !reflect.DeepEqual(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		a         []int
+		b         []string
+		expectedA []int
+		expectedB []string
+	}{
+		{"Equal lengths", []int{1, 2, 3}, []string{"a", "b", "c"}, []int{1, 2, 3}, []string{"a", "b", "c"}},
+		{"First shorter", []int{1, 2}, []string{"a", "b", "c"}, []int{1, 2}, []string{"a", "b"}},
+		{"Second shorter", []int{1, 2, 3}, []string{"a"}, []int{1}, []string{"a"}},
+		{"Empty input", []int{}, []string{}, []int{}, []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			a, b := Unzip(Zip(scenario.a, scenario.b))
+
+			if !slices.Equal(a, scenario.expectedA) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expectedA, a)
+			}
+
+			if !slices.Equal(b, scenario.expectedB) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expectedB, b)
+			}
+		})
+	}
+
+	t.Run("Nil input", func(t *testing.T) {
+		a, b := Unzip[int, string](nil)
+
+		if a == nil || b == nil || len(a) != 0 || len(b) != 0 {
+			t.Errorf("Expected two empty non-nil slices. Got %#v and %#v", a, b)
+		}
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	add := func(x, y int) int { return x + y }
+
+	scenarios := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected []int
+	}{
+		{"Equal lengths", []int{1, 2, 3}, []int{10, 20, 30}, []int{11, 22, 33}},
+		{"First shorter", []int{1}, []int{10, 20}, []int{11}},
+		{"Second shorter", []int{1, 2, 3}, []int{10, 20}, []int{11, 22}},
+		{"Empty slice", []int{}, []int{1, 2}, []int{}},
+		{"Nil slices", nil, nil, []int{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result := ZipWith(scenario.a, scenario.b, add)
+
+			if !reflect.DeepEqual(result, scenario.expected) {
+				t.Errorf("Expected result to be %#v. Got %#v", scenario.expected, result)
+			}
+		})
+	}
+
+	t.Run("Different types", func(t *testing.T) {
+		result := ZipWith([]string{"a", "b"}, []int{1, 2}, func(s string, n int) string {
+			return strings.Repeat(s, n)
+		})
+		expected := []string{"a", "bb"}
+
+		if !slices.Equal(result, expected) {
+			t.Errorf("Expected result to be %#v. Got %#v", expected, result)
+		}
+	})
+}
@@ -3,7 +3,11 @@
 // It supports dynamic resizing and is optimized for enqueue/dequeue performance without relying on third-party libraries.
 package ring
 
-import "slices"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 // InternalRingBuffer is a generic dynamically resizable circular buffer.
 // It supports enqueue and dequeue operations in constant amortized time,
@@ -57,7 +61,7 @@ func FromSlice[T any, A ~[]T](s A, capacity ...int) *InternalRingBuffer[T] {
 	return &InternalRingBuffer[T]{
 		data:     data,
 		capacity: desiredCapacity,
-		tail:     len(s),
+		tail:     len(s) % desiredCapacity,
 		size:     len(s),
 	}
 }
@@ -128,6 +132,19 @@ func (rb *InternalRingBuffer[T]) Peek() (T, bool) {
 	return rb.data[rb.head], true
 }
 
+// DequeueWhile removes and returns elements from the front of the buffer for
+// as long as pred returns true. The first element for which pred returns false
+// stays in the buffer. The buffer may shrink as elements are removed.
+func (rb *InternalRingBuffer[T]) DequeueWhile(pred func(T) bool) []T {
+	items := make([]T, 0)
+	for rb.size > 0 && pred(rb.data[rb.head]) {
+		val, _ := rb.Dequeue()
+		items = append(items, val)
+	}
+
+	return items
+}
+
 // ToSlice returns a new slice containing all elements in the buffer in their logical order.
 // The returned slice is independent of the internal buffer state.
 func (rb *InternalRingBuffer[T]) ToSlice() []T {
@@ -159,8 +176,104 @@ func (rb *InternalRingBuffer[T]) Clone() *InternalRingBuffer[T] {
 	}
 }
 
+// CloneCompact creates a deep copy of the source InternalRingBuffer whose
+// capacity equals its length, with a minimum capacity of 1.
+func (rb *InternalRingBuffer[T]) CloneCompact() *InternalRingBuffer[T] {
+	newCap := max(rb.size, 1)
+
+	newData := make([]T, newCap)
+	for i := 0; i < rb.size; i++ {
+		newData[i] = rb.data[(rb.head+i)%rb.capacity]
+	}
+
+	return &InternalRingBuffer[T]{
+		data:     newData,
+		head:     0,
+		tail:     rb.size % newCap,
+		size:     rb.size,
+		capacity: newCap,
+	}
+}
+
+// EqualsFunc reports whether rb and other hold the same elements in the same
+// logical order, using eq to compare elements. The physical position of the
+// elements inside either buffer does not affect the result.
+func (rb *InternalRingBuffer[T]) EqualsFunc(other *InternalRingBuffer[T], eq func(a, b T) bool) bool {
+	if rb.size != other.size {
+		return false
+	}
+
+	for i := 0; i < rb.size; i++ {
+		if !eq(rb.data[(rb.head+i)%rb.capacity], other.data[(other.head+i)%other.capacity]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a string representation of the buffer's contents in their
+// logical order, formatted like [1,2,3].
+func (rb *InternalRingBuffer[T]) String() string {
+	var sb strings.Builder
+
+	sb.WriteByte('[')
+	for i := 0; i < rb.size; i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+
+		fmt.Fprint(&sb, rb.data[(rb.head+i)%rb.capacity])
+	}
+	sb.WriteByte(']')
+
+	return sb.String()
+}
+
+// ForEach calls f for each element in the buffer in their logical order,
+// from head to tail, without allocating an intermediate slice.
+func (rb *InternalRingBuffer[T]) ForEach(f func(T)) {
+	for i := 0; i < rb.size; i++ {
+		f(rb.data[(rb.head+i)%rb.capacity])
+	}
+}
+
+// ForEachIndexed calls f for each element in the buffer in their logical order,
+// from head to tail, along with the element's logical index (0 being the head).
+func (rb *InternalRingBuffer[T]) ForEachIndexed(f func(int, T)) {
+	for i := 0; i < rb.size; i++ {
+		f(i, rb.data[(rb.head+i)%rb.capacity])
+	}
+}
+
+// Resize sets the capacity of the buffer to exactly newCap, reordering the
+// contents so that head = 0. It returns an error if newCap is smaller than the
+// number of stored elements or less than 1.
+func (rb *InternalRingBuffer[T]) Resize(newCap int) error {
+	if newCap < rb.size {
+		return fmt.Errorf("cannot resize buffer to capacity %d: it holds %d elements", newCap, rb.size)
+	}
+
+	if newCap < 1 {
+		return fmt.Errorf("cannot resize buffer to capacity %d: capacity must be at least 1", newCap)
+	}
+
+	rb.resize(newCap)
+
+	return nil
+}
+
+// Reset removes every element from the buffer while keeping its capacity.
+// The backing array is replaced so removed elements can be garbage collected.
+func (rb *InternalRingBuffer[T]) Reset() {
+	rb.data = make([]T, rb.capacity)
+	rb.head = 0
+	rb.tail = 0
+	rb.size = 0
+}
+
 // resize adjusts the capacity of the buffer to the specified value,
-// reordering the contents so that head = 0 and tail = size.
+// reordering the contents so that head = 0 and tail follows the last element.
 func (rb *InternalRingBuffer[T]) resize(newCap int) {
 	newData := make([]T, newCap)
 	for i := 0; i < rb.size; i++ {
@@ -169,6 +282,6 @@ func (rb *InternalRingBuffer[T]) resize(newCap int) {
 
 	rb.data = newData
 	rb.head = 0
-	rb.tail = rb.size
+	rb.tail = rb.size % newCap
 	rb.capacity = newCap
 }
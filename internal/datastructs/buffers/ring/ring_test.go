@@ -66,6 +66,25 @@ func TestInternalRingBuffer_FromSlice(t *testing.T) {
 	}
 }
 
+func TestInternalRingBuffer_FromSliceWrapsTail(t *testing.T) {
+	buf := FromSlice([]int{1, 2, 3, 4, 5})
+
+	if buf.tail != 0 {
+		t.Errorf("Expected buffer's tail to wrap to 0. Got %d.", buf.tail)
+	}
+
+	buf.Dequeue()
+	buf.Enqueue(6)
+
+	if !slices.Equal(buf.ToSlice(), []int{2, 3, 4, 5, 6}) {
+		t.Errorf("Expected buffer to be %#v. Got %#v", []int{2, 3, 4, 5, 6}, buf.ToSlice())
+	}
+
+	if buf.capacity != 5 {
+		t.Errorf("Expected buffer's capacity to stay 5. Got %d.", buf.capacity)
+	}
+}
+
 func TestInternalRingBuffer_Len(t *testing.T) {
 	scenarios := []struct {
 		name        string
@@ -302,6 +321,49 @@ func TestInternalRingBuffer_Dequeue(t *testing.T) {
 	}
 }
 
+func TestInternalRingBuffer_DequeueWhile(t *testing.T) {
+	t.Run("Stops at the first failing element", func(t *testing.T) {
+		buf := New[int](4)
+		buf.Enqueue(0, 0, 1, 2)
+		buf.Dequeue()
+		buf.Dequeue()
+		buf.Enqueue(3, 10, 4)
+
+		result := buf.DequeueWhile(func(n int) bool { return n < 5 })
+
+		if !slices.Equal(result, []int{1, 2, 3}) {
+			t.Errorf("Expected result to be %#v. Got %#v", []int{1, 2, 3}, result)
+		}
+
+		if !slices.Equal(buf.ToSlice(), []int{10, 4}) {
+			t.Errorf("Expected buffer to be %#v. Got %#v", []int{10, 4}, buf.ToSlice())
+		}
+	})
+
+	t.Run("Drains everything", func(t *testing.T) {
+		buf := New[int]()
+		buf.Enqueue(1, 2, 3)
+
+		result := buf.DequeueWhile(func(int) bool { return true })
+
+		if !slices.Equal(result, []int{1, 2, 3}) {
+			t.Errorf("Expected result to be %#v. Got %#v", []int{1, 2, 3}, result)
+		}
+
+		if !buf.IsEmpty() {
+			t.Errorf("Expected buffer to be empty. Got %#v", buf.ToSlice())
+		}
+	})
+
+	t.Run("Empty buffer", func(t *testing.T) {
+		buf := New[int]()
+
+		if result := buf.DequeueWhile(func(int) bool { return true }); len(result) != 0 {
+			t.Errorf("Expected result to be empty. Got %#v", result)
+		}
+	})
+}
+
 func TestInternalRingBuffer_Peek(t *testing.T) {
 	scenarios := []struct {
 		name         string
@@ -531,6 +593,305 @@ func TestInternalRingBuffer_Clone(t *testing.T) {
 	}
 }
 
+func TestInternalRingBuffer_CloneCompact(t *testing.T) {
+	scenarios := []struct {
+		name        string
+		setup       func() *InternalRingBuffer[int]
+		expected    []int
+		expectedCap int
+	}{
+		{
+			name: "Grown then drained",
+			setup: func() *InternalRingBuffer[int] {
+				buf := New[int]()
+				for i := 0; i < 100; i++ {
+					buf.Enqueue(i)
+				}
+				for i := 0; i < 95; i++ {
+					_, _ = buf.Dequeue()
+				}
+				return buf
+			},
+			expected:    []int{95, 96, 97, 98, 99},
+			expectedCap: 5,
+		},
+		{
+			name: "Wrapped buffer",
+			setup: func() *InternalRingBuffer[int] {
+				buf := New[int](4)
+				buf.Enqueue(1, 2, 3, 4)
+				_, _ = buf.Dequeue()
+				_, _ = buf.Dequeue()
+				buf.Enqueue(5)
+				return buf
+			},
+			expected:    []int{3, 4, 5},
+			expectedCap: 3,
+		},
+		{
+			name:        "Empty buffer",
+			setup:       func() *InternalRingBuffer[int] { return New[int](64) },
+			expected:    []int{},
+			expectedCap: 1,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			src := scenario.setup()
+			dst := src.CloneCompact()
+
+			if dst.Cap() != scenario.expectedCap {
+				t.Errorf("Expected capacity %d. Got %d", scenario.expectedCap, dst.Cap())
+			}
+
+			if !slices.Equal(dst.ToSlice(), scenario.expected) {
+				t.Errorf("Expected %v. Got %v", scenario.expected, dst.ToSlice())
+			}
+
+			// The clone must keep working as a normal buffer.
+			dst.Enqueue(100)
+			if !slices.Equal(dst.ToSlice(), append(slices.Clone(scenario.expected), 100)) {
+				t.Errorf("Expected %v. Got %v", append(slices.Clone(scenario.expected), 100), dst.ToSlice())
+			}
+
+			if !slices.Equal(src.ToSlice(), scenario.expected) {
+				t.Errorf("Expected source to be unchanged. Got %v", src.ToSlice())
+			}
+		})
+	}
+}
+
+func TestInternalRingBuffer_EqualsFunc(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	// b holds the same logical contents as a but with its head offset.
+	a := FromSlice([]int{3, 4, 5})
+	b := New[int]()
+	b.Enqueue(1, 2, 3, 4, 5)
+	_, _ = b.Dequeue()
+	_, _ = b.Dequeue()
+
+	if a.head == b.head {
+		t.Fatalf("Expected buffers to have different head positions. Got %d and %d", a.head, b.head)
+	}
+
+	if !a.EqualsFunc(b, eq) {
+		t.Errorf("Expected %v to equal %v", a.ToSlice(), b.ToSlice())
+	}
+
+	b.Enqueue(6)
+	if a.EqualsFunc(b, eq) {
+		t.Errorf("Expected %v to not equal %v", a.ToSlice(), b.ToSlice())
+	}
+
+	c := FromSlice([]int{3, 4, 6})
+	if a.EqualsFunc(c, eq) {
+		t.Errorf("Expected %v to not equal %v", a.ToSlice(), c.ToSlice())
+	}
+
+	if !New[int]().EqualsFunc(New[int](32), eq) {
+		t.Error("Expected empty buffers to be equal regardless of capacity")
+	}
+}
+
+func TestInternalRingBuffer_String(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setup    func() *InternalRingBuffer[int]
+		expected string
+	}{
+		{
+			name:     "Empty buffer",
+			setup:    func() *InternalRingBuffer[int] { return New[int]() },
+			expected: "[]",
+		},
+		{
+			name:     "Single element",
+			setup:    func() *InternalRingBuffer[int] { return FromSlice([]int{1}) },
+			expected: "[1]",
+		},
+		{
+			name: "Wrapped buffer",
+			setup: func() *InternalRingBuffer[int] {
+				buf := New[int](4)
+				buf.Enqueue(1, 2, 3, 4)
+				_, _ = buf.Dequeue()
+				_, _ = buf.Dequeue()
+				buf.Enqueue(5)
+				return buf
+			},
+			expected: "[3,4,5]",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			buf := scenario.setup()
+
+			if buf.String() != scenario.expected {
+				t.Errorf("Expected %q. Got %q", scenario.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestInternalRingBuffer_ForEach(t *testing.T) {
+	// Wrapped buffer: the logical head sits after the logical tail in memory.
+	buf := New[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5)
+
+	if buf.head <= buf.tail {
+		t.Fatalf("Expected buffer to wrap around. Got head %d and tail %d", buf.head, buf.tail)
+	}
+
+	var result []int
+	buf.ForEach(func(v int) {
+		result = append(result, v)
+	})
+
+	if !slices.Equal(result, []int{3, 4, 5}) {
+		t.Errorf("Expected %v. Got %v", []int{3, 4, 5}, result)
+	}
+
+	calls := 0
+	New[int]().ForEach(func(int) { calls++ })
+
+	if calls != 0 {
+		t.Errorf("Expected no calls on an empty buffer. Got %d", calls)
+	}
+}
+
+func TestInternalRingBuffer_ForEachIndexed(t *testing.T) {
+	buf := New[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5)
+
+	var indices, values []int
+	buf.ForEachIndexed(func(i, v int) {
+		indices = append(indices, i)
+		values = append(values, v)
+	})
+
+	if !slices.Equal(indices, []int{0, 1, 2}) {
+		t.Errorf("Expected indices %v. Got %v", []int{0, 1, 2}, indices)
+	}
+
+	if !slices.Equal(values, []int{3, 4, 5}) {
+		t.Errorf("Expected values %v. Got %v", []int{3, 4, 5}, values)
+	}
+}
+
+func TestInternalRingBuffer_Resize(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setup    func() *InternalRingBuffer[int]
+		resizeTo int
+		expected []int
+	}{
+		{
+			name: "Grow",
+			setup: func() *InternalRingBuffer[int] {
+				return FromSlice([]int{1, 2, 3})
+			},
+			resizeTo: 20,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name: "Shrink to fit wrapped data",
+			setup: func() *InternalRingBuffer[int] {
+				buf := New[int](4)
+				buf.Enqueue(1, 2, 3, 4)
+				_, _ = buf.Dequeue()
+				buf.Enqueue(5)
+				return buf
+			},
+			resizeTo: 4,
+			expected: []int{2, 3, 4, 5},
+		},
+		{
+			name: "Shrink empty buffer",
+			setup: func() *InternalRingBuffer[int] {
+				return New[int](16)
+			},
+			resizeTo: 1,
+			expected: []int{},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			buf := scenario.setup()
+
+			if err := buf.Resize(scenario.resizeTo); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if buf.Cap() != scenario.resizeTo {
+				t.Errorf("Expected capacity %d. Got %d", scenario.resizeTo, buf.Cap())
+			}
+
+			if buf.head != 0 {
+				t.Errorf("Expected head to be reset to 0. Got %d", buf.head)
+			}
+
+			if !slices.Equal(buf.ToSlice(), scenario.expected) {
+				t.Errorf("Expected %v. Got %v", scenario.expected, buf.ToSlice())
+			}
+
+			// The buffer must keep working after being resized.
+			_, _ = buf.Dequeue()
+			buf.Enqueue(100, 101)
+			if last := buf.ToSlice()[buf.Len()-1]; last != 101 {
+				t.Errorf("Expected last element to be 101. Got %d", last)
+			}
+		})
+	}
+
+	t.Run("Capacity too small", func(t *testing.T) {
+		buf := FromSlice([]int{1, 2, 3}, 16)
+
+		if err := buf.Resize(2); err == nil {
+			t.Error("Expected an error when resizing below the current length")
+		}
+
+		if err := buf.Resize(0); err == nil {
+			t.Error("Expected an error when resizing to zero")
+		}
+
+		if buf.Cap() != 16 || !slices.Equal(buf.ToSlice(), []int{1, 2, 3}) {
+			t.Errorf("Expected buffer to be unchanged. Got %v with capacity %d", buf.ToSlice(), buf.Cap())
+		}
+	})
+}
+
+func TestInternalRingBuffer_Reset(t *testing.T) {
+	buf := New[int](4)
+	buf.Enqueue(1, 2, 3, 4)
+	_, _ = buf.Dequeue()
+	buf.Enqueue(5)
+
+	buf.Reset()
+
+	if !buf.IsEmpty() {
+		t.Errorf("Expected buffer to be empty. Got %v", buf.ToSlice())
+	}
+
+	if buf.Cap() != 4 {
+		t.Errorf("Expected capacity to stay 4. Got %d", buf.Cap())
+	}
+
+	buf.Enqueue(6, 7)
+	if !slices.Equal(buf.ToSlice(), []int{6, 7}) {
+		t.Errorf("Expected %v. Got %v", []int{6, 7}, buf.ToSlice())
+	}
+}
+
 func TestInternalRingBuffer_resize(t *testing.T) {
 	scenarios := []struct {
 		name         string
@@ -574,8 +935,8 @@ func TestInternalRingBuffer_resize(t *testing.T) {
 			if buf.head != 0 {
 				t.Errorf("Expected head to be reset to 0, got %d", buf.head)
 			}
-			if buf.tail != buf.size {
-				t.Errorf("Expected tail == size (%d), got %d", buf.size, buf.tail)
+			if buf.tail != buf.size%buf.capacity {
+				t.Errorf("Expected tail == size %% capacity (%d), got %d", buf.size%buf.capacity, buf.tail)
 			}
 			if buf.size != len(scenario.expectedData) {
 				t.Fatalf("Expected size %d, got %d", len(scenario.expectedData), buf.size)
@@ -60,6 +60,31 @@ func (t *InternalTuple[T]) Set(index int, v T) bool {
 	return false
 }
 
+// Swap exchanges the elements at indices i and j.
+// It returns true if the operation was successful, or false if either index was out of bounds.
+func (t *InternalTuple[T]) Swap(i, j int) bool {
+	if i < 0 || i >= len(t.vars) || j < 0 || j >= len(t.vars) {
+		return false
+	}
+
+	t.vars[i], t.vars[j] = t.vars[j], t.vars[i]
+
+	return true
+}
+
+// Fill sets every element of the InternalTuple to v.
+func (t *InternalTuple[T]) Fill(v T) {
+	for index := range t.vars {
+		t.vars[index] = v
+	}
+}
+
+// IndexFunc returns the index of the first element satisfying pred,
+// or -1 if no element does.
+func (t *InternalTuple[T]) IndexFunc(pred func(T) bool) int {
+	return slices.IndexFunc(t.vars, pred)
+}
+
 // ToSlice returns a copy of the InternalTuple's internal values as a slice.
 func (t *InternalTuple[T]) ToSlice() []T {
 	return slices.Clone(t.vars)
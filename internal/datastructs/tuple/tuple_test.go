@@ -154,6 +154,47 @@ func TestInternalTuple_Set(t *testing.T) {
 	}
 }
 
+func TestInternalTuple_Swap(t *testing.T) {
+	tup := New(1, 2, 3)
+
+	if !tup.Swap(0, 2) {
+		t.Error("Failed to swap first and last elements.")
+	}
+
+	if !slices.Equal(tup.ToSlice(), []int{3, 2, 1}) {
+		t.Errorf("Expected %#v. Got %#v", []int{3, 2, 1}, tup.ToSlice())
+	}
+
+	if tup.Swap(0, 3) || tup.Swap(-1, 1) {
+		t.Error("Swapped element outside of valid range.")
+	}
+
+	if !slices.Equal(tup.ToSlice(), []int{3, 2, 1}) {
+		t.Errorf("Expected failed swaps to leave %#v. Got %#v", []int{3, 2, 1}, tup.ToSlice())
+	}
+}
+
+func TestInternalTuple_Fill(t *testing.T) {
+	tup := New(1, 2, 3)
+	tup.Fill(7)
+
+	if !slices.Equal(tup.ToSlice(), []int{7, 7, 7}) {
+		t.Errorf("Expected %#v. Got %#v", []int{7, 7, 7}, tup.ToSlice())
+	}
+}
+
+func TestInternalTuple_IndexFunc(t *testing.T) {
+	tup := New(1, 2, 3, 2)
+
+	if index := tup.IndexFunc(func(n int) bool { return n == 2 }); index != 1 {
+		t.Errorf("Expected index to be 1. Got %d", index)
+	}
+
+	if index := tup.IndexFunc(func(n int) bool { return n > 10 }); index != -1 {
+		t.Errorf("Expected index to be -1. Got %d", index)
+	}
+}
+
 func TestInternalTuple_ToSlice(t *testing.T) {
 	scenarios := []struct {
 		name string